@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.6
+// 	protoc-gen-go v1.36.10
 // 	protoc        (unknown)
 // source: book_management_system/v1/book.proto
 
@@ -21,6 +21,298 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ImageOrigin int32
+
+const (
+	ImageOrigin_IMAGE_ORIGIN_UNKNOWN ImageOrigin = 0
+	// プロバイダーから取得した表紙
+	ImageOrigin_PROVIDER ImageOrigin = 1
+	// UploadCover でアップロードした表紙 (プロバイダーの表紙で置き換えない)
+	ImageOrigin_USER ImageOrigin = 2
+	// 表紙が無い本に作った仮の表紙 (書名と著者を書いた SVG)
+	ImageOrigin_GENERATED ImageOrigin = 3
+	// SelectCover で候補から選んだ表紙 (プロバイダーの表紙で置き換えない)
+	ImageOrigin_SELECTED ImageOrigin = 4
+)
+
+// Enum value maps for ImageOrigin.
+var (
+	ImageOrigin_name = map[int32]string{
+		0: "IMAGE_ORIGIN_UNKNOWN",
+		1: "PROVIDER",
+		2: "USER",
+		3: "GENERATED",
+		4: "SELECTED",
+	}
+	ImageOrigin_value = map[string]int32{
+		"IMAGE_ORIGIN_UNKNOWN": 0,
+		"PROVIDER":             1,
+		"USER":                 2,
+		"GENERATED":            3,
+		"SELECTED":             4,
+	}
+)
+
+func (x ImageOrigin) Enum() *ImageOrigin {
+	p := new(ImageOrigin)
+	*p = x
+	return p
+}
+
+func (x ImageOrigin) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImageOrigin) Descriptor() protoreflect.EnumDescriptor {
+	return file_book_management_system_v1_book_proto_enumTypes[0].Descriptor()
+}
+
+func (ImageOrigin) Type() protoreflect.EnumType {
+	return &file_book_management_system_v1_book_proto_enumTypes[0]
+}
+
+func (x ImageOrigin) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImageOrigin.Descriptor instead.
+func (ImageOrigin) EnumDescriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{0}
+}
+
+type AttachmentKind int32
+
+const (
+	AttachmentKind_ATTACHMENT_KIND_UNKNOWN AttachmentKind = 0
+	AttachmentKind_BACK_COVER              AttachmentKind = 1
+	AttachmentKind_SPINE                   AttachmentKind = 2
+	// 本文やサインのあるページなど
+	AttachmentKind_INTERIOR AttachmentKind = 3
+	// 傷や汚れ
+	AttachmentKind_DAMAGE AttachmentKind = 4
+	AttachmentKind_OTHER  AttachmentKind = 5
+)
+
+// Enum value maps for AttachmentKind.
+var (
+	AttachmentKind_name = map[int32]string{
+		0: "ATTACHMENT_KIND_UNKNOWN",
+		1: "BACK_COVER",
+		2: "SPINE",
+		3: "INTERIOR",
+		4: "DAMAGE",
+		5: "OTHER",
+	}
+	AttachmentKind_value = map[string]int32{
+		"ATTACHMENT_KIND_UNKNOWN": 0,
+		"BACK_COVER":              1,
+		"SPINE":                   2,
+		"INTERIOR":                3,
+		"DAMAGE":                  4,
+		"OTHER":                   5,
+	}
+)
+
+func (x AttachmentKind) Enum() *AttachmentKind {
+	p := new(AttachmentKind)
+	*p = x
+	return p
+}
+
+func (x AttachmentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttachmentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_book_management_system_v1_book_proto_enumTypes[1].Descriptor()
+}
+
+func (AttachmentKind) Type() protoreflect.EnumType {
+	return &file_book_management_system_v1_book_proto_enumTypes[1]
+}
+
+func (x AttachmentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttachmentKind.Descriptor instead.
+func (AttachmentKind) EnumDescriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{1}
+}
+
+type DatePrecision int32
+
+const (
+	DatePrecision_DATE_PRECISION_UNKNOWN DatePrecision = 0
+	DatePrecision_YEAR                   DatePrecision = 1
+	DatePrecision_MONTH                  DatePrecision = 2
+	DatePrecision_DAY                    DatePrecision = 3
+)
+
+// Enum value maps for DatePrecision.
+var (
+	DatePrecision_name = map[int32]string{
+		0: "DATE_PRECISION_UNKNOWN",
+		1: "YEAR",
+		2: "MONTH",
+		3: "DAY",
+	}
+	DatePrecision_value = map[string]int32{
+		"DATE_PRECISION_UNKNOWN": 0,
+		"YEAR":                   1,
+		"MONTH":                  2,
+		"DAY":                    3,
+	}
+)
+
+func (x DatePrecision) Enum() *DatePrecision {
+	p := new(DatePrecision)
+	*p = x
+	return p
+}
+
+func (x DatePrecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatePrecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_book_management_system_v1_book_proto_enumTypes[2].Descriptor()
+}
+
+func (DatePrecision) Type() protoreflect.EnumType {
+	return &file_book_management_system_v1_book_proto_enumTypes[2]
+}
+
+func (x DatePrecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatePrecision.Descriptor instead.
+func (DatePrecision) EnumDescriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{2}
+}
+
+type ContributorRole int32
+
+const (
+	ContributorRole_CONTRIBUTOR_ROLE_UNKNOWN ContributorRole = 0
+	ContributorRole_AUTHOR                   ContributorRole = 1
+	ContributorRole_TRANSLATOR               ContributorRole = 2
+	ContributorRole_ILLUSTRATOR              ContributorRole = 3
+	ContributorRole_EDITOR                   ContributorRole = 4
+	// 原作者
+	ContributorRole_ORIGINAL_CREATOR ContributorRole = 5
+)
+
+// Enum value maps for ContributorRole.
+var (
+	ContributorRole_name = map[int32]string{
+		0: "CONTRIBUTOR_ROLE_UNKNOWN",
+		1: "AUTHOR",
+		2: "TRANSLATOR",
+		3: "ILLUSTRATOR",
+		4: "EDITOR",
+		5: "ORIGINAL_CREATOR",
+	}
+	ContributorRole_value = map[string]int32{
+		"CONTRIBUTOR_ROLE_UNKNOWN": 0,
+		"AUTHOR":                   1,
+		"TRANSLATOR":               2,
+		"ILLUSTRATOR":              3,
+		"EDITOR":                   4,
+		"ORIGINAL_CREATOR":         5,
+	}
+)
+
+func (x ContributorRole) Enum() *ContributorRole {
+	p := new(ContributorRole)
+	*p = x
+	return p
+}
+
+func (x ContributorRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContributorRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_book_management_system_v1_book_proto_enumTypes[3].Descriptor()
+}
+
+func (ContributorRole) Type() protoreflect.EnumType {
+	return &file_book_management_system_v1_book_proto_enumTypes[3]
+}
+
+func (x ContributorRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContributorRole.Descriptor instead.
+func (ContributorRole) EnumDescriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{3}
+}
+
+type IdentifierType int32
+
+const (
+	IdentifierType_IDENTIFIER_TYPE_UNKNOWN IdentifierType = 0
+	IdentifierType_ISBN                    IdentifierType = 1
+	// 全国書誌番号
+	IdentifierType_JPNO IdentifierType = 2
+	IdentifierType_NCID IdentifierType = 3
+	IdentifierType_ASIN IdentifierType = 4
+	IdentifierType_ISSN IdentifierType = 5
+	IdentifierType_OCLC IdentifierType = 6
+)
+
+// Enum value maps for IdentifierType.
+var (
+	IdentifierType_name = map[int32]string{
+		0: "IDENTIFIER_TYPE_UNKNOWN",
+		1: "ISBN",
+		2: "JPNO",
+		3: "NCID",
+		4: "ASIN",
+		5: "ISSN",
+		6: "OCLC",
+	}
+	IdentifierType_value = map[string]int32{
+		"IDENTIFIER_TYPE_UNKNOWN": 0,
+		"ISBN":                    1,
+		"JPNO":                    2,
+		"NCID":                    3,
+		"ASIN":                    4,
+		"ISSN":                    5,
+		"OCLC":                    6,
+	}
+)
+
+func (x IdentifierType) Enum() *IdentifierType {
+	p := new(IdentifierType)
+	*p = x
+	return p
+}
+
+func (x IdentifierType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdentifierType) Descriptor() protoreflect.EnumDescriptor {
+	return file_book_management_system_v1_book_proto_enumTypes[4].Descriptor()
+}
+
+func (IdentifierType) Type() protoreflect.EnumType {
+	return &file_book_management_system_v1_book_proto_enumTypes[4]
+}
+
+func (x IdentifierType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdentifierType.Descriptor instead.
+func (IdentifierType) EnumDescriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{4}
+}
+
 type Language int32
 
 const (
@@ -54,11 +346,11 @@ func (x Language) String() string {
 }
 
 func (Language) Descriptor() protoreflect.EnumDescriptor {
-	return file_book_management_system_v1_book_proto_enumTypes[0].Descriptor()
+	return file_book_management_system_v1_book_proto_enumTypes[5].Descriptor()
 }
 
 func (Language) Type() protoreflect.EnumType {
-	return &file_book_management_system_v1_book_proto_enumTypes[0]
+	return &file_book_management_system_v1_book_proto_enumTypes[5]
 }
 
 func (x Language) Number() protoreflect.EnumNumber {
@@ -67,12 +359,14 @@ func (x Language) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Language.Descriptor instead.
 func (Language) EnumDescriptor() ([]byte, []int) {
-	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{0}
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{5}
 }
 
 type PutBookRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Isbn          string                 `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Isbn  string                 `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	// ISBN の無い本を JP番号などで登録する場合
+	Identifier    *Identifier `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,6 +408,13 @@ func (x *PutBookRequest) GetIsbn() string {
 	return ""
 }
 
+func (x *PutBookRequest) GetIdentifier() *Identifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
 type PutBookResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Book          *Book                  `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
@@ -153,33 +454,2385 @@ func (*PutBookResponse) Descriptor() ([]byte, []int) {
 
 func (x *PutBookResponse) GetBook() *Book {
 	if x != nil {
-		return x.Book
+		return x.Book
+	}
+	return nil
+}
+
+// 書誌情報を手入力で登録する
+type CreateBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Book          *Book                  `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookRequest) Reset() {
+	*x = CreateBookRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookRequest) ProtoMessage() {}
+
+func (x *CreateBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateBookRequest) GetBook() *Book {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+type CreateBookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Book          *Book                  `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookResponse) Reset() {
+	*x = CreateBookResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookResponse) ProtoMessage() {}
+
+func (x *CreateBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateBookResponse) GetBook() *Book {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+type GetBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Isbn          string                 `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookRequest) Reset() {
+	*x = GetBookRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookRequest) ProtoMessage() {}
+
+func (x *GetBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookRequest.ProtoReflect.Descriptor instead.
+func (*GetBookRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetBookRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *GetBookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Book          *Book                  `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookResponse) Reset() {
+	*x = GetBookResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookResponse) ProtoMessage() {}
+
+func (x *GetBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookResponse.ProtoReflect.Descriptor instead.
+func (*GetBookResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetBookResponse) GetBook() *Book {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+type GetAllBooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllBooksRequest) Reset() {
+	*x = GetAllBooksRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllBooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllBooksRequest) ProtoMessage() {}
+
+func (x *GetAllBooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllBooksRequest.ProtoReflect.Descriptor instead.
+func (*GetAllBooksRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{6}
+}
+
+type GetAllBooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Books         []*Book                `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllBooksResponse) Reset() {
+	*x = GetAllBooksResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllBooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllBooksResponse) ProtoMessage() {}
+
+func (x *GetAllBooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllBooksResponse.ProtoReflect.Descriptor instead.
+func (*GetAllBooksResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAllBooksResponse) GetBooks() []*Book {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+type SearchBookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 空のフィールドはその条件で絞らない
+	Title         string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Publisher     string `protobuf:"bytes,2,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	Subject       string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBookRequest) Reset() {
+	*x = SearchBookRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBookRequest) ProtoMessage() {}
+
+func (x *SearchBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBookRequest.ProtoReflect.Descriptor instead.
+func (*SearchBookRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchBookRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchBookRequest) GetPublisher() string {
+	if x != nil {
+		return x.Publisher
+	}
+	return ""
+}
+
+func (x *SearchBookRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type SearchBookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Books         []*Book                `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBookResponse) Reset() {
+	*x = SearchBookResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBookResponse) ProtoMessage() {}
+
+func (x *SearchBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBookResponse.ProtoReflect.Descriptor instead.
+func (*SearchBookResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchBookResponse) GetBooks() []*Book {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+type Book struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Isbn        string                 `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Authors     []string               `protobuf:"bytes,3,rep,name=authors,proto3" json:"authors,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// 分かっている精度で "2006", "2006-01", "2006-01-02" のいずれか
+	Publishdate string `protobuf:"bytes,5,opt,name=publishdate,proto3" json:"publishdate,omitempty"`
+	// languages に置き換えた (languages の先頭が日本語か英語の場合だけ入る)
+	//
+	// Deprecated: Marked as deprecated in book_management_system/v1/book.proto.
+	Language Language `protobuf:"varint,6,opt,name=language,proto3,enum=book_management_system.v1.Language" json:"language,omitempty"`
+	// 保存した表紙の完全な URL (public_url/images/... か署名付き URL)
+	Imageurl    string        `protobuf:"bytes,7,opt,name=imageurl,proto3" json:"imageurl,omitempty"`
+	Id          string        `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
+	Identifiers []*Identifier `protobuf:"bytes,9,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Publisher   string        `protobuf:"bytes,10,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	Pages       int32         `protobuf:"varint,11,opt,name=pages,proto3" json:"pages,omitempty"`
+	Subjects    []string      `protobuf:"bytes,12,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	Edition     string        `protobuf:"bytes,13,opt,name=edition,proto3" json:"edition,omitempty"`
+	Price       *Price        `protobuf:"bytes,14,opt,name=price,proto3" json:"price,omitempty"`
+	// authors は表示用の著者名で、役割は contributors にある
+	Contributors []*Contributor `protobuf:"bytes,15,rep,name=contributors,proto3" json:"contributors,omitempty"`
+	// 日本十進分類法 (NDC) の分類記号
+	Ndc string `protobuf:"bytes,16,opt,name=ndc,proto3" json:"ndc,omitempty"`
+	// シリーズ名 ("新潮文庫" など)
+	Series               string        `protobuf:"bytes,17,opt,name=series,proto3" json:"series,omitempty"`
+	PublishdatePrecision DatePrecision `protobuf:"varint,18,opt,name=publishdate_precision,json=publishdatePrecision,proto3,enum=book_management_system.v1.DatePrecision" json:"publishdate_precision,omitempty"`
+	// 本文の言語の ISO 639 コード ("ja", "en", "zh" など)。対訳本などは複数
+	Languages []string `protobuf:"bytes,19,rep,name=languages,proto3" json:"languages,omitempty"`
+	// 表紙の代表色 ("#rrggbb") と読み込み中に表示する blurhash
+	ImageColor    string      `protobuf:"bytes,20,opt,name=image_color,json=imageColor,proto3" json:"image_color,omitempty"`
+	ImageBlurhash string      `protobuf:"bytes,21,opt,name=image_blurhash,json=imageBlurhash,proto3" json:"image_blurhash,omitempty"`
+	ImageOrigin   ImageOrigin `protobuf:"varint,22,opt,name=image_origin,json=imageOrigin,proto3,enum=book_management_system.v1.ImageOrigin" json:"image_origin,omitempty"`
+	// 書名の読み (分からない場合は空)
+	TitleReading  string `protobuf:"bytes,23,opt,name=title_reading,json=titleReading,proto3" json:"title_reading,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Book) Reset() {
+	*x = Book{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Book) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Book) ProtoMessage() {}
+
+func (x *Book) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Book.ProtoReflect.Descriptor instead.
+func (*Book) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Book) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *Book) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Book) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *Book) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Book) GetPublishdate() string {
+	if x != nil {
+		return x.Publishdate
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in book_management_system/v1/book.proto.
+func (x *Book) GetLanguage() Language {
+	if x != nil {
+		return x.Language
+	}
+	return Language_UNKNOWN
+}
+
+func (x *Book) GetImageurl() string {
+	if x != nil {
+		return x.Imageurl
+	}
+	return ""
+}
+
+func (x *Book) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Book) GetIdentifiers() []*Identifier {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+func (x *Book) GetPublisher() string {
+	if x != nil {
+		return x.Publisher
+	}
+	return ""
+}
+
+func (x *Book) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *Book) GetSubjects() []string {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *Book) GetEdition() string {
+	if x != nil {
+		return x.Edition
+	}
+	return ""
+}
+
+func (x *Book) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Book) GetContributors() []*Contributor {
+	if x != nil {
+		return x.Contributors
+	}
+	return nil
+}
+
+func (x *Book) GetNdc() string {
+	if x != nil {
+		return x.Ndc
+	}
+	return ""
+}
+
+func (x *Book) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *Book) GetPublishdatePrecision() DatePrecision {
+	if x != nil {
+		return x.PublishdatePrecision
+	}
+	return DatePrecision_DATE_PRECISION_UNKNOWN
+}
+
+func (x *Book) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *Book) GetImageColor() string {
+	if x != nil {
+		return x.ImageColor
+	}
+	return ""
+}
+
+func (x *Book) GetImageBlurhash() string {
+	if x != nil {
+		return x.ImageBlurhash
+	}
+	return ""
+}
+
+func (x *Book) GetImageOrigin() ImageOrigin {
+	if x != nil {
+		return x.ImageOrigin
+	}
+	return ImageOrigin_IMAGE_ORIGIN_UNKNOWN
+}
+
+func (x *Book) GetTitleReading() string {
+	if x != nil {
+		return x.TitleReading
+	}
+	return ""
+}
+
+type Contributor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role  ContributorRole        `protobuf:"varint,2,opt,name=role,proto3,enum=book_management_system.v1.ContributorRole" json:"role,omitempty"`
+	// 登録済みの本の場合は Author の id
+	AuthorId int64 `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// 名前の読み (分からない場合は空)
+	Reading       string `protobuf:"bytes,4,opt,name=reading,proto3" json:"reading,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Contributor) Reset() {
+	*x = Contributor{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contributor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contributor) ProtoMessage() {}
+
+func (x *Contributor) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contributor.ProtoReflect.Descriptor instead.
+func (*Contributor) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Contributor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contributor) GetRole() ContributorRole {
+	if x != nil {
+		return x.Role
+	}
+	return ContributorRole_CONTRIBUTOR_ROLE_UNKNOWN
+}
+
+func (x *Contributor) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Contributor) GetReading() string {
+	if x != nil {
+		return x.Reading
+	}
+	return ""
+}
+
+type Price struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Amount float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// ISO 4217 の通貨コード
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Price) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Price) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Identifier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          IdentifierType         `protobuf:"varint,1,opt,name=type,proto3,enum=book_management_system.v1.IdentifierType" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Identifier) Reset() {
+	*x = Identifier{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Identifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identifier) ProtoMessage() {}
+
+func (x *Identifier) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identifier.ProtoReflect.Descriptor instead.
+func (*Identifier) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Identifier) GetType() IdentifierType {
+	if x != nil {
+		return x.Type
+	}
+	return IdentifierType_IDENTIFIER_TYPE_UNKNOWN
+}
+
+func (x *Identifier) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type RenameBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Isbn          string                 `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameBookRequest) Reset() {
+	*x = RenameBookRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameBookRequest) ProtoMessage() {}
+
+func (x *RenameBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameBookRequest.ProtoReflect.Descriptor instead.
+func (*RenameBookRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RenameBookRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *RenameBookRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RenameBookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RenameBookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameBookResponse) Reset() {
+	*x = RenameBookResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameBookResponse) ProtoMessage() {}
+
+func (x *RenameBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameBookResponse.ProtoReflect.Descriptor instead.
+func (*RenameBookResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{15}
+}
+
+type DeleteBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Isbn          string                 `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBookRequest) Reset() {
+	*x = DeleteBookRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookRequest) ProtoMessage() {}
+
+func (x *DeleteBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBookRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteBookRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *DeleteBookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteBookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBookResponse) Reset() {
+	*x = DeleteBookResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookResponse) ProtoMessage() {}
+
+func (x *DeleteBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBookResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{17}
+}
+
+// 表紙の画像を分割して送る
+// 最初のメッセージで id か isbn を指定し、chunk を順に送る
+type UploadCoverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Isbn          string                 `protobuf:"bytes,2,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCoverRequest) Reset() {
+	*x = UploadCoverRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCoverRequest) ProtoMessage() {}
+
+func (x *UploadCoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCoverRequest.ProtoReflect.Descriptor instead.
+func (*UploadCoverRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UploadCoverRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadCoverRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *UploadCoverRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type UploadCoverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Book          *Book                  `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCoverResponse) Reset() {
+	*x = UploadCoverResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCoverResponse) ProtoMessage() {}
+
+func (x *UploadCoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCoverResponse.ProtoReflect.Descriptor instead.
+func (*UploadCoverResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UploadCoverResponse) GetBook() *Book {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+// 表紙の他に本に付けた写真
+type Attachment struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind    AttachmentKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=book_management_system.v1.AttachmentKind" json:"kind,omitempty"`
+	Caption string                 `protobuf:"bytes,3,opt,name=caption,proto3" json:"caption,omitempty"`
+	// 画像の完全な URL (public_url/images/... か署名付き URL)
+	Imageurl string `protobuf:"bytes,4,opt,name=imageurl,proto3" json:"imageurl,omitempty"`
+	Width    int32  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height   int32  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// 0 から始まる表示順
+	Position      int32 `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetKind() AttachmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNKNOWN
+}
+
+func (x *Attachment) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *Attachment) GetImageurl() string {
+	if x != nil {
+		return x.Imageurl
+	}
+	return ""
+}
+
+func (x *Attachment) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Attachment) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Attachment) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// 最初のメッセージで本 (id か isbn) と kind, caption を指定し、画像を chunk で送る
+type UploadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Isbn          string                 `protobuf:"bytes,2,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Kind          AttachmentKind         `protobuf:"varint,3,opt,name=kind,proto3,enum=book_management_system.v1.AttachmentKind" json:"kind,omitempty"`
+	Caption       string                 `protobuf:"bytes,4,opt,name=caption,proto3" json:"caption,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,5,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UploadAttachmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadAttachmentRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *UploadAttachmentRequest) GetKind() AttachmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNKNOWN
+}
+
+func (x *UploadAttachmentRequest) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Isbn          string                 `protobuf:"bytes,2,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListAttachmentsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListAttachmentsRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Isbn          string                 `protobuf:"bytes,2,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	AttachmentId  string                 `protobuf:"bytes,3,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteAttachmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteAttachmentRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *DeleteAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{26}
+}
+
+type ReorderAttachmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Isbn  string                 `protobuf:"bytes,2,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	// 本の写真の id を全て新しい順に並べたもの
+	AttachmentIds []string `protobuf:"bytes,3,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderAttachmentsRequest) Reset() {
+	*x = ReorderAttachmentsRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderAttachmentsRequest) ProtoMessage() {}
+
+func (x *ReorderAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReorderAttachmentsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReorderAttachmentsRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *ReorderAttachmentsRequest) GetAttachmentIds() []string {
+	if x != nil {
+		return x.AttachmentIds
+	}
+	return nil
+}
+
+type ReorderAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderAttachmentsResponse) Reset() {
+	*x = ReorderAttachmentsResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderAttachmentsResponse) ProtoMessage() {}
+
+func (x *ReorderAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReorderAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type ListCoverCandidatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Isbn          string                 `protobuf:"bytes,2,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoverCandidatesRequest) Reset() {
+	*x = ListCoverCandidatesRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoverCandidatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoverCandidatesRequest) ProtoMessage() {}
+
+func (x *ListCoverCandidatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoverCandidatesRequest.ProtoReflect.Descriptor instead.
+func (*ListCoverCandidatesRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListCoverCandidatesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListCoverCandidatesRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+type ListCoverCandidatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidates    []*CoverCandidate      `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoverCandidatesResponse) Reset() {
+	*x = ListCoverCandidatesResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoverCandidatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoverCandidatesResponse) ProtoMessage() {}
+
+func (x *ListCoverCandidatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoverCandidatesResponse.ProtoReflect.Descriptor instead.
+func (*ListCoverCandidatesResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListCoverCandidatesResponse) GetCandidates() []*CoverCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// プロバイダーが返した表紙の候補
+type CoverCandidate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// プロバイダーの名前
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// プロバイダーでの大きさの区別 ("thumbnail", "large" など)
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Url   string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// 取得できなかった場合は 0
+	Width  int32 `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// 今の表紙の取得元か
+	Selected      bool `protobuf:"varint,6,opt,name=selected,proto3" json:"selected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoverCandidate) Reset() {
+	*x = CoverCandidate{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoverCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoverCandidate) ProtoMessage() {}
+
+func (x *CoverCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoverCandidate.ProtoReflect.Descriptor instead.
+func (*CoverCandidate) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CoverCandidate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CoverCandidate) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CoverCandidate) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CoverCandidate) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CoverCandidate) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CoverCandidate) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+type SelectCoverRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Isbn  string                 `protobuf:"bytes,2,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	// ListCoverCandidates が返した url
+	Url           string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectCoverRequest) Reset() {
+	*x = SelectCoverRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectCoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectCoverRequest) ProtoMessage() {}
+
+func (x *SelectCoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectCoverRequest.ProtoReflect.Descriptor instead.
+func (*SelectCoverRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SelectCoverRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SelectCoverRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *SelectCoverRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type SelectCoverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Book          *Book                  `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectCoverResponse) Reset() {
+	*x = SelectCoverResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectCoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectCoverResponse) ProtoMessage() {}
+
+func (x *SelectCoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectCoverResponse.ProtoReflect.Descriptor instead.
+func (*SelectCoverResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SelectCoverResponse) GetBook() *Book {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+type SearchCatalogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCatalogRequest) Reset() {
+	*x = SearchCatalogRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCatalogRequest) ProtoMessage() {}
+
+func (x *SearchCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCatalogRequest.ProtoReflect.Descriptor instead.
+func (*SearchCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SearchCatalogRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchCatalogRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type SearchCatalogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidates    []*CatalogCandidate    `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCatalogResponse) Reset() {
+	*x = SearchCatalogResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCatalogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCatalogResponse) ProtoMessage() {}
+
+func (x *SearchCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCatalogResponse.ProtoReflect.Descriptor instead.
+func (*SearchCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SearchCatalogResponse) GetCandidates() []*CatalogCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type CatalogCandidate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Book  *Book                  `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	// 候補を返したプロバイダー
+	Sources       []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogCandidate) Reset() {
+	*x = CatalogCandidate{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogCandidate) ProtoMessage() {}
+
+func (x *CatalogCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogCandidate.ProtoReflect.Descriptor instead.
+func (*CatalogCandidate) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CatalogCandidate) GetBook() *Book {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+func (x *CatalogCandidate) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type ProviderCacheEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Isbn          string                 `protobuf:"bytes,2,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	NotFound      bool                   `protobuf:"varint,3,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	CreatedTime   string                 `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	ExpiresTime   string                 `protobuf:"bytes,5,opt,name=expires_time,json=expiresTime,proto3" json:"expires_time,omitempty"`
+	Response      string                 `protobuf:"bytes,6,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderCacheEntry) Reset() {
+	*x = ProviderCacheEntry{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderCacheEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderCacheEntry) ProtoMessage() {}
+
+func (x *ProviderCacheEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderCacheEntry.ProtoReflect.Descriptor instead.
+func (*ProviderCacheEntry) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ProviderCacheEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ProviderCacheEntry) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *ProviderCacheEntry) GetNotFound() bool {
+	if x != nil {
+		return x.NotFound
+	}
+	return false
+}
+
+func (x *ProviderCacheEntry) GetCreatedTime() string {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return ""
+}
+
+func (x *ProviderCacheEntry) GetExpiresTime() string {
+	if x != nil {
+		return x.ExpiresTime
+	}
+	return ""
+}
+
+func (x *ProviderCacheEntry) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type ListProviderCacheRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 空の場合は全て
+	Isbn          string `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProviderCacheRequest) Reset() {
+	*x = ListProviderCacheRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProviderCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProviderCacheRequest) ProtoMessage() {}
+
+func (x *ListProviderCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProviderCacheRequest.ProtoReflect.Descriptor instead.
+func (*ListProviderCacheRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListProviderCacheRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+type ListProviderCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*ProviderCacheEntry  `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProviderCacheResponse) Reset() {
+	*x = ListProviderCacheResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProviderCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProviderCacheResponse) ProtoMessage() {}
+
+func (x *ListProviderCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProviderCacheResponse.ProtoReflect.Descriptor instead.
+func (*ListProviderCacheResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListProviderCacheResponse) GetEntries() []*ProviderCacheEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type InvalidateProviderCacheRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 空の場合はその条件で絞らない
+	Isbn          string `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Source        string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateProviderCacheRequest) Reset() {
+	*x = InvalidateProviderCacheRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateProviderCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateProviderCacheRequest) ProtoMessage() {}
+
+func (x *InvalidateProviderCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateProviderCacheRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateProviderCacheRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *InvalidateProviderCacheRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *InvalidateProviderCacheRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type InvalidateProviderCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateProviderCacheResponse) Reset() {
+	*x = InvalidateProviderCacheResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateProviderCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateProviderCacheResponse) ProtoMessage() {}
+
+func (x *InvalidateProviderCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateProviderCacheResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateProviderCacheResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{41}
+}
+
+// Author は表記ゆれをまとめた人物
+type Author struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 代表の表記
+	Name          string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Reading       string   `protobuf:"bytes,3,opt,name=reading,proto3" json:"reading,omitempty"`
+	Aliases       []string `protobuf:"bytes,4,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Author) Reset() {
+	*x = Author{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Author) ProtoMessage() {}
+
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Author) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Author) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Author) GetReading() string {
+	if x != nil {
+		return x.Reading
+	}
+	return ""
+}
+
+func (x *Author) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
 	}
 	return nil
 }
 
-type GetBookRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Isbn          string                 `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
+type ListAuthorsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 表記か別名に含まれる文字列 (空の場合は全て)
+	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookRequest) Reset() {
-	*x = GetBookRequest{}
-	mi := &file_book_management_system_v1_book_proto_msgTypes[2]
+func (x *ListAuthorsRequest) Reset() {
+	*x = ListAuthorsRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookRequest) String() string {
+func (x *ListAuthorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookRequest) ProtoMessage() {}
+func (*ListAuthorsRequest) ProtoMessage() {}
 
-func (x *GetBookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_book_management_system_v1_book_proto_msgTypes[2]
+func (x *ListAuthorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -190,40 +2843,40 @@ func (x *GetBookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookRequest.ProtoReflect.Descriptor instead.
-func (*GetBookRequest) Descriptor() ([]byte, []int) {
-	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListAuthorsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthorsRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetBookRequest) GetIsbn() string {
+func (x *ListAuthorsRequest) GetQuery() string {
 	if x != nil {
-		return x.Isbn
+		return x.Query
 	}
 	return ""
 }
 
-type GetBookResponse struct {
+type ListAuthorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Book          *Book                  `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	Authors       []*Author              `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookResponse) Reset() {
-	*x = GetBookResponse{}
-	mi := &file_book_management_system_v1_book_proto_msgTypes[3]
+func (x *ListAuthorsResponse) Reset() {
+	*x = ListAuthorsResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookResponse) String() string {
+func (x *ListAuthorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookResponse) ProtoMessage() {}
+func (*ListAuthorsResponse) ProtoMessage() {}
 
-func (x *GetBookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_book_management_system_v1_book_proto_msgTypes[3]
+func (x *ListAuthorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,39 +2887,42 @@ func (x *GetBookResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookResponse.ProtoReflect.Descriptor instead.
-func (*GetBookResponse) Descriptor() ([]byte, []int) {
-	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListAuthorsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthorsResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetBookResponse) GetBook() *Book {
+func (x *ListAuthorsResponse) GetAuthors() []*Author {
 	if x != nil {
-		return x.Book
+		return x.Authors
 	}
 	return nil
 }
 
-type GetAllBooksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type ListBooksByAuthorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// author_id が無い場合は name を別名も含めて探す
+	AuthorId      int64  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllBooksRequest) Reset() {
-	*x = GetAllBooksRequest{}
-	mi := &file_book_management_system_v1_book_proto_msgTypes[4]
+func (x *ListBooksByAuthorRequest) Reset() {
+	*x = ListBooksByAuthorRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllBooksRequest) String() string {
+func (x *ListBooksByAuthorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllBooksRequest) ProtoMessage() {}
+func (*ListBooksByAuthorRequest) ProtoMessage() {}
 
-func (x *GetAllBooksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_book_management_system_v1_book_proto_msgTypes[4]
+func (x *ListBooksByAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -277,33 +2933,48 @@ func (x *GetAllBooksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllBooksRequest.ProtoReflect.Descriptor instead.
-func (*GetAllBooksRequest) Descriptor() ([]byte, []int) {
-	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListBooksByAuthorRequest.ProtoReflect.Descriptor instead.
+func (*ListBooksByAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{45}
 }
 
-type GetAllBooksResponse struct {
+func (x *ListBooksByAuthorRequest) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *ListBooksByAuthorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListBooksByAuthorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Books         []*Book                `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Books         []*Book                `protobuf:"bytes,2,rep,name=books,proto3" json:"books,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllBooksResponse) Reset() {
-	*x = GetAllBooksResponse{}
-	mi := &file_book_management_system_v1_book_proto_msgTypes[5]
+func (x *ListBooksByAuthorResponse) Reset() {
+	*x = ListBooksByAuthorResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllBooksResponse) String() string {
+func (x *ListBooksByAuthorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllBooksResponse) ProtoMessage() {}
+func (*ListBooksByAuthorResponse) ProtoMessage() {}
 
-func (x *GetAllBooksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_book_management_system_v1_book_proto_msgTypes[5]
+func (x *ListBooksByAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -314,40 +2985,47 @@ func (x *GetAllBooksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllBooksResponse.ProtoReflect.Descriptor instead.
-func (*GetAllBooksResponse) Descriptor() ([]byte, []int) {
-	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListBooksByAuthorResponse.ProtoReflect.Descriptor instead.
+func (*ListBooksByAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetAllBooksResponse) GetBooks() []*Book {
+func (x *ListBooksByAuthorResponse) GetAuthor() *Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *ListBooksByAuthorResponse) GetBooks() []*Book {
 	if x != nil {
 		return x.Books
 	}
 	return nil
 }
 
-type SearchBookRequest struct {
+type UpdateAuthorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchBookRequest) Reset() {
-	*x = SearchBookRequest{}
-	mi := &file_book_management_system_v1_book_proto_msgTypes[6]
+func (x *UpdateAuthorRequest) Reset() {
+	*x = UpdateAuthorRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchBookRequest) String() string {
+func (x *UpdateAuthorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchBookRequest) ProtoMessage() {}
+func (*UpdateAuthorRequest) ProtoMessage() {}
 
-func (x *SearchBookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_book_management_system_v1_book_proto_msgTypes[6]
+func (x *UpdateAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,40 +3036,40 @@ func (x *SearchBookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchBookRequest.ProtoReflect.Descriptor instead.
-func (*SearchBookRequest) Descriptor() ([]byte, []int) {
-	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use UpdateAuthorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *SearchBookRequest) GetTitle() string {
+func (x *UpdateAuthorRequest) GetAuthor() *Author {
 	if x != nil {
-		return x.Title
+		return x.Author
 	}
-	return ""
+	return nil
 }
 
-type SearchBookResponse struct {
+type UpdateAuthorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Books         []*Book                `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchBookResponse) Reset() {
-	*x = SearchBookResponse{}
-	mi := &file_book_management_system_v1_book_proto_msgTypes[7]
+func (x *UpdateAuthorResponse) Reset() {
+	*x = UpdateAuthorResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchBookResponse) String() string {
+func (x *UpdateAuthorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchBookResponse) ProtoMessage() {}
+func (*UpdateAuthorResponse) ProtoMessage() {}
 
-func (x *SearchBookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_book_management_system_v1_book_proto_msgTypes[7]
+func (x *UpdateAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,46 +3080,42 @@ func (x *SearchBookResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchBookResponse.ProtoReflect.Descriptor instead.
-func (*SearchBookResponse) Descriptor() ([]byte, []int) {
-	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UpdateAuthorResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *SearchBookResponse) GetBooks() []*Book {
+func (x *UpdateAuthorResponse) GetAuthor() *Author {
 	if x != nil {
-		return x.Books
+		return x.Author
 	}
 	return nil
 }
 
-type Book struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Isbn          string                 `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Authors       []string               `protobuf:"bytes,3,rep,name=authors,proto3" json:"authors,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Publishdate   string                 `protobuf:"bytes,5,opt,name=publishdate,proto3" json:"publishdate,omitempty"`
-	Language      Language               `protobuf:"varint,6,opt,name=language,proto3,enum=book_management_system.v1.Language" json:"language,omitempty"`
-	Imageurl      string                 `protobuf:"bytes,7,opt,name=imageurl,proto3" json:"imageurl,omitempty"`
+type MergeAuthorsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// source_ids の別名と本を target_id にまとめる
+	TargetId      int64   `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	SourceIds     []int64 `protobuf:"varint,2,rep,packed,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Book) Reset() {
-	*x = Book{}
-	mi := &file_book_management_system_v1_book_proto_msgTypes[8]
+func (x *MergeAuthorsRequest) Reset() {
+	*x = MergeAuthorsRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Book) String() string {
+func (x *MergeAuthorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Book) ProtoMessage() {}
+func (*MergeAuthorsRequest) ProtoMessage() {}
 
-func (x *Book) ProtoReflect() protoreflect.Message {
-	mi := &file_book_management_system_v1_book_proto_msgTypes[8]
+func (x *MergeAuthorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,83 +3126,47 @@ func (x *Book) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Book.ProtoReflect.Descriptor instead.
-func (*Book) Descriptor() ([]byte, []int) {
-	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *Book) GetIsbn() string {
-	if x != nil {
-		return x.Isbn
-	}
-	return ""
+// Deprecated: Use MergeAuthorsRequest.ProtoReflect.Descriptor instead.
+func (*MergeAuthorsRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *Book) GetTitle() string {
+func (x *MergeAuthorsRequest) GetTargetId() int64 {
 	if x != nil {
-		return x.Title
+		return x.TargetId
 	}
-	return ""
+	return 0
 }
 
-func (x *Book) GetAuthors() []string {
+func (x *MergeAuthorsRequest) GetSourceIds() []int64 {
 	if x != nil {
-		return x.Authors
+		return x.SourceIds
 	}
 	return nil
 }
 
-func (x *Book) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Book) GetPublishdate() string {
-	if x != nil {
-		return x.Publishdate
-	}
-	return ""
-}
-
-func (x *Book) GetLanguage() Language {
-	if x != nil {
-		return x.Language
-	}
-	return Language_UNKNOWN
-}
-
-func (x *Book) GetImageurl() string {
-	if x != nil {
-		return x.Imageurl
-	}
-	return ""
-}
-
-type RenameBookRequest struct {
+type MergeAuthorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Isbn          string                 `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RenameBookRequest) Reset() {
-	*x = RenameBookRequest{}
-	mi := &file_book_management_system_v1_book_proto_msgTypes[9]
+func (x *MergeAuthorsResponse) Reset() {
+	*x = MergeAuthorsResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameBookRequest) String() string {
+func (x *MergeAuthorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameBookRequest) ProtoMessage() {}
+func (*MergeAuthorsResponse) ProtoMessage() {}
 
-func (x *RenameBookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_book_management_system_v1_book_proto_msgTypes[9]
+func (x *MergeAuthorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,46 +3177,42 @@ func (x *RenameBookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameBookRequest.ProtoReflect.Descriptor instead.
-func (*RenameBookRequest) Descriptor() ([]byte, []int) {
-	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *RenameBookRequest) GetIsbn() string {
-	if x != nil {
-		return x.Isbn
-	}
-	return ""
+// Deprecated: Use MergeAuthorsResponse.ProtoReflect.Descriptor instead.
+func (*MergeAuthorsResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *RenameBookRequest) GetTitle() string {
+func (x *MergeAuthorsResponse) GetAuthor() *Author {
 	if x != nil {
-		return x.Title
+		return x.Author
 	}
-	return ""
+	return nil
 }
 
-type RenameBookResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type BrowseClassificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 空の場合は類、1 桁の場合は綱、2 桁の場合は目の一覧を返す
+	// 3 桁の場合はその目の本を返す
+	Prefix        string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RenameBookResponse) Reset() {
-	*x = RenameBookResponse{}
-	mi := &file_book_management_system_v1_book_proto_msgTypes[10]
+func (x *BrowseClassificationRequest) Reset() {
+	*x = BrowseClassificationRequest{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameBookResponse) String() string {
+func (x *BrowseClassificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameBookResponse) ProtoMessage() {}
+func (*BrowseClassificationRequest) ProtoMessage() {}
 
-func (x *RenameBookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_book_management_system_v1_book_proto_msgTypes[10]
+func (x *BrowseClassificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,33 +3223,41 @@ func (x *RenameBookResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameBookResponse.ProtoReflect.Descriptor instead.
-func (*RenameBookResponse) Descriptor() ([]byte, []int) {
-	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use BrowseClassificationRequest.ProtoReflect.Descriptor instead.
+func (*BrowseClassificationRequest) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{51}
 }
 
-type DeleteBookRequest struct {
+func (x *BrowseClassificationRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type BrowseClassificationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Isbn          string                 `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Nodes         []*ClassificationNode  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Books         []*Book                `protobuf:"bytes,2,rep,name=books,proto3" json:"books,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBookRequest) Reset() {
-	*x = DeleteBookRequest{}
-	mi := &file_book_management_system_v1_book_proto_msgTypes[11]
+func (x *BrowseClassificationResponse) Reset() {
+	*x = BrowseClassificationResponse{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBookRequest) String() string {
+func (x *BrowseClassificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBookRequest) ProtoMessage() {}
+func (*BrowseClassificationResponse) ProtoMessage() {}
 
-func (x *DeleteBookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_book_management_system_v1_book_proto_msgTypes[11]
+func (x *BrowseClassificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -626,39 +3268,50 @@ func (x *DeleteBookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBookRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBookRequest) Descriptor() ([]byte, []int) {
-	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use BrowseClassificationResponse.ProtoReflect.Descriptor instead.
+func (*BrowseClassificationResponse) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *DeleteBookRequest) GetIsbn() string {
+func (x *BrowseClassificationResponse) GetNodes() []*ClassificationNode {
 	if x != nil {
-		return x.Isbn
+		return x.Nodes
 	}
-	return ""
+	return nil
 }
 
-type DeleteBookResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *BrowseClassificationResponse) GetBooks() []*Book {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+type ClassificationNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// 類と綱の名前 (目は空)
+	Label         string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Count         int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBookResponse) Reset() {
-	*x = DeleteBookResponse{}
-	mi := &file_book_management_system_v1_book_proto_msgTypes[12]
+func (x *ClassificationNode) Reset() {
+	*x = ClassificationNode{}
+	mi := &file_book_management_system_v1_book_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBookResponse) String() string {
+func (x *ClassificationNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBookResponse) ProtoMessage() {}
+func (*ClassificationNode) ProtoMessage() {}
 
-func (x *DeleteBookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_book_management_system_v1_book_proto_msgTypes[12]
+func (x *ClassificationNode) ProtoReflect() protoreflect.Message {
+	mi := &file_book_management_system_v1_book_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,52 +3322,275 @@ func (x *DeleteBookResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBookResponse.ProtoReflect.Descriptor instead.
-func (*DeleteBookResponse) Descriptor() ([]byte, []int) {
-	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ClassificationNode.ProtoReflect.Descriptor instead.
+func (*ClassificationNode) Descriptor() ([]byte, []int) {
+	return file_book_management_system_v1_book_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ClassificationNode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ClassificationNode) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ClassificationNode) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
 var File_book_management_system_v1_book_proto protoreflect.FileDescriptor
 
 const file_book_management_system_v1_book_proto_rawDesc = "" +
 	"\n" +
-	"$book_management_system/v1/book.proto\x12\x19book_management_system.v1\"$\n" +
+	"$book_management_system/v1/book.proto\x12\x19book_management_system.v1\"k\n" +
 	"\x0ePutBookRequest\x12\x12\n" +
-	"\x04isbn\x18\x01 \x01(\tR\x04isbn\"F\n" +
+	"\x04isbn\x18\x01 \x01(\tR\x04isbn\x12E\n" +
+	"\n" +
+	"identifier\x18\x02 \x01(\v2%.book_management_system.v1.IdentifierR\n" +
+	"identifier\"F\n" +
 	"\x0fPutBookResponse\x123\n" +
-	"\x04book\x18\x01 \x01(\v2\x1f.book_management_system.v1.BookR\x04book\"$\n" +
+	"\x04book\x18\x01 \x01(\v2\x1f.book_management_system.v1.BookR\x04book\"H\n" +
+	"\x11CreateBookRequest\x123\n" +
+	"\x04book\x18\x01 \x01(\v2\x1f.book_management_system.v1.BookR\x04book\"I\n" +
+	"\x12CreateBookResponse\x123\n" +
+	"\x04book\x18\x01 \x01(\v2\x1f.book_management_system.v1.BookR\x04book\"4\n" +
 	"\x0eGetBookRequest\x12\x12\n" +
-	"\x04isbn\x18\x01 \x01(\tR\x04isbn\"F\n" +
+	"\x04isbn\x18\x01 \x01(\tR\x04isbn\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"F\n" +
 	"\x0fGetBookResponse\x123\n" +
 	"\x04book\x18\x01 \x01(\v2\x1f.book_management_system.v1.BookR\x04book\"\x14\n" +
 	"\x12GetAllBooksRequest\"L\n" +
 	"\x13GetAllBooksResponse\x125\n" +
-	"\x05books\x18\x01 \x03(\v2\x1f.book_management_system.v1.BookR\x05books\")\n" +
+	"\x05books\x18\x01 \x03(\v2\x1f.book_management_system.v1.BookR\x05books\"a\n" +
 	"\x11SearchBookRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\"K\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1c\n" +
+	"\tpublisher\x18\x02 \x01(\tR\tpublisher\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\"K\n" +
 	"\x12SearchBookResponse\x125\n" +
-	"\x05books\x18\x01 \x03(\v2\x1f.book_management_system.v1.BookR\x05books\"\xeb\x01\n" +
+	"\x05books\x18\x01 \x03(\v2\x1f.book_management_system.v1.BookR\x05books\"\x95\a\n" +
 	"\x04Book\x12\x12\n" +
 	"\x04isbn\x18\x01 \x01(\tR\x04isbn\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\aauthors\x18\x03 \x03(\tR\aauthors\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12 \n" +
-	"\vpublishdate\x18\x05 \x01(\tR\vpublishdate\x12?\n" +
-	"\blanguage\x18\x06 \x01(\x0e2#.book_management_system.v1.LanguageR\blanguage\x12\x1a\n" +
-	"\bimageurl\x18\a \x01(\tR\bimageurl\"=\n" +
+	"\vpublishdate\x18\x05 \x01(\tR\vpublishdate\x12C\n" +
+	"\blanguage\x18\x06 \x01(\x0e2#.book_management_system.v1.LanguageB\x02\x18\x01R\blanguage\x12\x1a\n" +
+	"\bimageurl\x18\a \x01(\tR\bimageurl\x12\x0e\n" +
+	"\x02id\x18\b \x01(\tR\x02id\x12G\n" +
+	"\videntifiers\x18\t \x03(\v2%.book_management_system.v1.IdentifierR\videntifiers\x12\x1c\n" +
+	"\tpublisher\x18\n" +
+	" \x01(\tR\tpublisher\x12\x14\n" +
+	"\x05pages\x18\v \x01(\x05R\x05pages\x12\x1a\n" +
+	"\bsubjects\x18\f \x03(\tR\bsubjects\x12\x18\n" +
+	"\aedition\x18\r \x01(\tR\aedition\x126\n" +
+	"\x05price\x18\x0e \x01(\v2 .book_management_system.v1.PriceR\x05price\x12J\n" +
+	"\fcontributors\x18\x0f \x03(\v2&.book_management_system.v1.ContributorR\fcontributors\x12\x10\n" +
+	"\x03ndc\x18\x10 \x01(\tR\x03ndc\x12\x16\n" +
+	"\x06series\x18\x11 \x01(\tR\x06series\x12]\n" +
+	"\x15publishdate_precision\x18\x12 \x01(\x0e2(.book_management_system.v1.DatePrecisionR\x14publishdatePrecision\x12\x1c\n" +
+	"\tlanguages\x18\x13 \x03(\tR\tlanguages\x12\x1f\n" +
+	"\vimage_color\x18\x14 \x01(\tR\n" +
+	"imageColor\x12%\n" +
+	"\x0eimage_blurhash\x18\x15 \x01(\tR\rimageBlurhash\x12I\n" +
+	"\fimage_origin\x18\x16 \x01(\x0e2&.book_management_system.v1.ImageOriginR\vimageOrigin\x12#\n" +
+	"\rtitle_reading\x18\x17 \x01(\tR\ftitleReading\"\x98\x01\n" +
+	"\vContributor\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12>\n" +
+	"\x04role\x18\x02 \x01(\x0e2*.book_management_system.v1.ContributorRoleR\x04role\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\x03R\bauthorId\x12\x18\n" +
+	"\areading\x18\x04 \x01(\tR\areading\";\n" +
+	"\x05Price\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"a\n" +
+	"\n" +
+	"Identifier\x12=\n" +
+	"\x04type\x18\x01 \x01(\x0e2).book_management_system.v1.IdentifierTypeR\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"M\n" +
 	"\x11RenameBookRequest\x12\x12\n" +
 	"\x04isbn\x18\x01 \x01(\tR\x04isbn\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\"\x14\n" +
-	"\x12RenameBookResponse\"'\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\"\x14\n" +
+	"\x12RenameBookResponse\"7\n" +
 	"\x11DeleteBookRequest\x12\x12\n" +
-	"\x04isbn\x18\x01 \x01(\tR\x04isbn\"\x14\n" +
-	"\x12DeleteBookResponse*2\n" +
+	"\x04isbn\x18\x01 \x01(\tR\x04isbn\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteBookResponse\"N\n" +
+	"\x12UploadCoverRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04isbn\x18\x02 \x01(\tR\x04isbn\x12\x14\n" +
+	"\x05chunk\x18\x03 \x01(\fR\x05chunk\"J\n" +
+	"\x13UploadCoverResponse\x123\n" +
+	"\x04book\x18\x01 \x01(\v2\x1f.book_management_system.v1.BookR\x04book\"\xdb\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
+	"\x04kind\x18\x02 \x01(\x0e2).book_management_system.v1.AttachmentKindR\x04kind\x12\x18\n" +
+	"\acaption\x18\x03 \x01(\tR\acaption\x12\x1a\n" +
+	"\bimageurl\x18\x04 \x01(\tR\bimageurl\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x05R\x06height\x12\x1a\n" +
+	"\bposition\x18\a \x01(\x05R\bposition\"\xac\x01\n" +
+	"\x17UploadAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04isbn\x18\x02 \x01(\tR\x04isbn\x12=\n" +
+	"\x04kind\x18\x03 \x01(\x0e2).book_management_system.v1.AttachmentKindR\x04kind\x12\x18\n" +
+	"\acaption\x18\x04 \x01(\tR\acaption\x12\x14\n" +
+	"\x05chunk\x18\x05 \x01(\fR\x05chunk\"a\n" +
+	"\x18UploadAttachmentResponse\x12E\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2%.book_management_system.v1.AttachmentR\n" +
+	"attachment\"<\n" +
+	"\x16ListAttachmentsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04isbn\x18\x02 \x01(\tR\x04isbn\"b\n" +
+	"\x17ListAttachmentsResponse\x12G\n" +
+	"\vattachments\x18\x01 \x03(\v2%.book_management_system.v1.AttachmentR\vattachments\"b\n" +
+	"\x17DeleteAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04isbn\x18\x02 \x01(\tR\x04isbn\x12#\n" +
+	"\rattachment_id\x18\x03 \x01(\tR\fattachmentId\"\x1a\n" +
+	"\x18DeleteAttachmentResponse\"f\n" +
+	"\x19ReorderAttachmentsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04isbn\x18\x02 \x01(\tR\x04isbn\x12%\n" +
+	"\x0eattachment_ids\x18\x03 \x03(\tR\rattachmentIds\"e\n" +
+	"\x1aReorderAttachmentsResponse\x12G\n" +
+	"\vattachments\x18\x01 \x03(\v2%.book_management_system.v1.AttachmentR\vattachments\"@\n" +
+	"\x1aListCoverCandidatesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04isbn\x18\x02 \x01(\tR\x04isbn\"h\n" +
+	"\x1bListCoverCandidatesResponse\x12I\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2).book_management_system.v1.CoverCandidateR\n" +
+	"candidates\"\x9a\x01\n" +
+	"\x0eCoverCandidate\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x05R\x06height\x12\x1a\n" +
+	"\bselected\x18\x06 \x01(\bR\bselected\"J\n" +
+	"\x12SelectCoverRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04isbn\x18\x02 \x01(\tR\x04isbn\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\"J\n" +
+	"\x13SelectCoverResponse\x123\n" +
+	"\x04book\x18\x01 \x01(\v2\x1f.book_management_system.v1.BookR\x04book\"D\n" +
+	"\x14SearchCatalogRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
+	"\x06author\x18\x02 \x01(\tR\x06author\"d\n" +
+	"\x15SearchCatalogResponse\x12K\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2+.book_management_system.v1.CatalogCandidateR\n" +
+	"candidates\"a\n" +
+	"\x10CatalogCandidate\x123\n" +
+	"\x04book\x18\x01 \x01(\v2\x1f.book_management_system.v1.BookR\x04book\x12\x18\n" +
+	"\asources\x18\x02 \x03(\tR\asources\"\xbf\x01\n" +
+	"\x12ProviderCacheEntry\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x12\n" +
+	"\x04isbn\x18\x02 \x01(\tR\x04isbn\x12\x1b\n" +
+	"\tnot_found\x18\x03 \x01(\bR\bnotFound\x12!\n" +
+	"\fcreated_time\x18\x04 \x01(\tR\vcreatedTime\x12!\n" +
+	"\fexpires_time\x18\x05 \x01(\tR\vexpiresTime\x12\x1a\n" +
+	"\bresponse\x18\x06 \x01(\tR\bresponse\".\n" +
+	"\x18ListProviderCacheRequest\x12\x12\n" +
+	"\x04isbn\x18\x01 \x01(\tR\x04isbn\"d\n" +
+	"\x19ListProviderCacheResponse\x12G\n" +
+	"\aentries\x18\x01 \x03(\v2-.book_management_system.v1.ProviderCacheEntryR\aentries\"L\n" +
+	"\x1eInvalidateProviderCacheRequest\x12\x12\n" +
+	"\x04isbn\x18\x01 \x01(\tR\x04isbn\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\"!\n" +
+	"\x1fInvalidateProviderCacheResponse\"`\n" +
+	"\x06Author\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\areading\x18\x03 \x01(\tR\areading\x12\x18\n" +
+	"\aaliases\x18\x04 \x03(\tR\aaliases\"*\n" +
+	"\x12ListAuthorsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"R\n" +
+	"\x13ListAuthorsResponse\x12;\n" +
+	"\aauthors\x18\x01 \x03(\v2!.book_management_system.v1.AuthorR\aauthors\"K\n" +
+	"\x18ListBooksByAuthorRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\x03R\bauthorId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x8d\x01\n" +
+	"\x19ListBooksByAuthorResponse\x129\n" +
+	"\x06author\x18\x01 \x01(\v2!.book_management_system.v1.AuthorR\x06author\x125\n" +
+	"\x05books\x18\x02 \x03(\v2\x1f.book_management_system.v1.BookR\x05books\"P\n" +
+	"\x13UpdateAuthorRequest\x129\n" +
+	"\x06author\x18\x01 \x01(\v2!.book_management_system.v1.AuthorR\x06author\"Q\n" +
+	"\x14UpdateAuthorResponse\x129\n" +
+	"\x06author\x18\x01 \x01(\v2!.book_management_system.v1.AuthorR\x06author\"Q\n" +
+	"\x13MergeAuthorsRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x03R\btargetId\x12\x1d\n" +
+	"\n" +
+	"source_ids\x18\x02 \x03(\x03R\tsourceIds\"Q\n" +
+	"\x14MergeAuthorsResponse\x129\n" +
+	"\x06author\x18\x01 \x01(\v2!.book_management_system.v1.AuthorR\x06author\"5\n" +
+	"\x1bBrowseClassificationRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\"\x9a\x01\n" +
+	"\x1cBrowseClassificationResponse\x12C\n" +
+	"\x05nodes\x18\x01 \x03(\v2-.book_management_system.v1.ClassificationNodeR\x05nodes\x125\n" +
+	"\x05books\x18\x02 \x03(\v2\x1f.book_management_system.v1.BookR\x05books\"T\n" +
+	"\x12ClassificationNode\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count*\\\n" +
+	"\vImageOrigin\x12\x18\n" +
+	"\x14IMAGE_ORIGIN_UNKNOWN\x10\x00\x12\f\n" +
+	"\bPROVIDER\x10\x01\x12\b\n" +
+	"\x04USER\x10\x02\x12\r\n" +
+	"\tGENERATED\x10\x03\x12\f\n" +
+	"\bSELECTED\x10\x04*m\n" +
+	"\x0eAttachmentKind\x12\x1b\n" +
+	"\x17ATTACHMENT_KIND_UNKNOWN\x10\x00\x12\x0e\n" +
+	"\n" +
+	"BACK_COVER\x10\x01\x12\t\n" +
+	"\x05SPINE\x10\x02\x12\f\n" +
+	"\bINTERIOR\x10\x03\x12\n" +
+	"\n" +
+	"\x06DAMAGE\x10\x04\x12\t\n" +
+	"\x05OTHER\x10\x05*I\n" +
+	"\rDatePrecision\x12\x1a\n" +
+	"\x16DATE_PRECISION_UNKNOWN\x10\x00\x12\b\n" +
+	"\x04YEAR\x10\x01\x12\t\n" +
+	"\x05MONTH\x10\x02\x12\a\n" +
+	"\x03DAY\x10\x03*~\n" +
+	"\x0fContributorRole\x12\x1c\n" +
+	"\x18CONTRIBUTOR_ROLE_UNKNOWN\x10\x00\x12\n" +
+	"\n" +
+	"\x06AUTHOR\x10\x01\x12\x0e\n" +
+	"\n" +
+	"TRANSLATOR\x10\x02\x12\x0f\n" +
+	"\vILLUSTRATOR\x10\x03\x12\n" +
+	"\n" +
+	"\x06EDITOR\x10\x04\x12\x14\n" +
+	"\x10ORIGINAL_CREATOR\x10\x05*i\n" +
+	"\x0eIdentifierType\x12\x1b\n" +
+	"\x17IDENTIFIER_TYPE_UNKNOWN\x10\x00\x12\b\n" +
+	"\x04ISBN\x10\x01\x12\b\n" +
+	"\x04JPNO\x10\x02\x12\b\n" +
+	"\x04NCID\x10\x03\x12\b\n" +
+	"\x04ASIN\x10\x04\x12\b\n" +
+	"\x04ISSN\x10\x05\x12\b\n" +
+	"\x04OCLC\x10\x06*2\n" +
 	"\bLanguage\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\v\n" +
 	"\aENGLISH\x10\x01\x12\f\n" +
-	"\bJAPANESE\x10\x022\x8a\x05\n" +
+	"\bJAPANESE\x10\x022\xb5\x14\n" +
 	"\x15BookManagementService\x12`\n" +
-	"\aPutBook\x12).book_management_system.v1.PutBookRequest\x1a*.book_management_system.v1.PutBookResponse\x12`\n" +
+	"\aPutBook\x12).book_management_system.v1.PutBookRequest\x1a*.book_management_system.v1.PutBookResponse\x12i\n" +
+	"\n" +
+	"CreateBook\x12,.book_management_system.v1.CreateBookRequest\x1a-.book_management_system.v1.CreateBookResponse\x12`\n" +
 	"\aGetBook\x12).book_management_system.v1.GetBookRequest\x1a*.book_management_system.v1.GetBookResponse\x12l\n" +
 	"\vGetAllBooks\x12-.book_management_system.v1.GetAllBooksRequest\x1a..book_management_system.v1.GetAllBooksResponse\x12i\n" +
 	"\n" +
@@ -722,7 +3598,22 @@ const file_book_management_system_v1_book_proto_rawDesc = "" +
 	"\n" +
 	"RenameBook\x12,.book_management_system.v1.RenameBookRequest\x1a-.book_management_system.v1.RenameBookResponse\x12i\n" +
 	"\n" +
-	"DeleteBook\x12,.book_management_system.v1.DeleteBookRequest\x1a-.book_management_system.v1.DeleteBookResponseB\x93\x02\n" +
+	"DeleteBook\x12,.book_management_system.v1.DeleteBookRequest\x1a-.book_management_system.v1.DeleteBookResponse\x12r\n" +
+	"\rSearchCatalog\x12/.book_management_system.v1.SearchCatalogRequest\x1a0.book_management_system.v1.SearchCatalogResponse\x12n\n" +
+	"\vUploadCover\x12-.book_management_system.v1.UploadCoverRequest\x1a..book_management_system.v1.UploadCoverResponse(\x01\x12\x84\x01\n" +
+	"\x13ListCoverCandidates\x125.book_management_system.v1.ListCoverCandidatesRequest\x1a6.book_management_system.v1.ListCoverCandidatesResponse\x12l\n" +
+	"\vSelectCover\x12-.book_management_system.v1.SelectCoverRequest\x1a..book_management_system.v1.SelectCoverResponse\x12}\n" +
+	"\x10UploadAttachment\x122.book_management_system.v1.UploadAttachmentRequest\x1a3.book_management_system.v1.UploadAttachmentResponse(\x01\x12x\n" +
+	"\x0fListAttachments\x121.book_management_system.v1.ListAttachmentsRequest\x1a2.book_management_system.v1.ListAttachmentsResponse\x12{\n" +
+	"\x10DeleteAttachment\x122.book_management_system.v1.DeleteAttachmentRequest\x1a3.book_management_system.v1.DeleteAttachmentResponse\x12\x81\x01\n" +
+	"\x12ReorderAttachments\x124.book_management_system.v1.ReorderAttachmentsRequest\x1a5.book_management_system.v1.ReorderAttachmentsResponse\x12l\n" +
+	"\vListAuthors\x12-.book_management_system.v1.ListAuthorsRequest\x1a..book_management_system.v1.ListAuthorsResponse\x12~\n" +
+	"\x11ListBooksByAuthor\x123.book_management_system.v1.ListBooksByAuthorRequest\x1a4.book_management_system.v1.ListBooksByAuthorResponse\x12o\n" +
+	"\fUpdateAuthor\x12..book_management_system.v1.UpdateAuthorRequest\x1a/.book_management_system.v1.UpdateAuthorResponse\x12o\n" +
+	"\fMergeAuthors\x12..book_management_system.v1.MergeAuthorsRequest\x1a/.book_management_system.v1.MergeAuthorsResponse\x12\x87\x01\n" +
+	"\x14BrowseClassification\x126.book_management_system.v1.BrowseClassificationRequest\x1a7.book_management_system.v1.BrowseClassificationResponse\x12~\n" +
+	"\x11ListProviderCache\x123.book_management_system.v1.ListProviderCacheRequest\x1a4.book_management_system.v1.ListProviderCacheResponse\x12\x90\x01\n" +
+	"\x17InvalidateProviderCache\x129.book_management_system.v1.InvalidateProviderCacheRequest\x1a:.book_management_system.v1.InvalidateProviderCacheResponseB\x93\x02\n" +
 	"\x1dcom.book_management_system.v1B\tBookProtoP\x01Zjgithub.com/nyahahanoha/BookManagementSystem/backend/api/book_management_system/v1;book_management_systemv1\xa2\x02\x03BXX\xaa\x02\x17BookManagementSystem.V1\xca\x02\x17BookManagementSystem\\V1\xe2\x02#BookManagementSystem\\V1\\GPBMetadata\xea\x02\x18BookManagementSystem::V1b\x06proto3"
 
 var (
@@ -737,47 +3628,154 @@ func file_book_management_system_v1_book_proto_rawDescGZIP() []byte {
 	return file_book_management_system_v1_book_proto_rawDescData
 }
 
-var file_book_management_system_v1_book_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_book_management_system_v1_book_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_book_management_system_v1_book_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_book_management_system_v1_book_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_book_management_system_v1_book_proto_goTypes = []any{
-	(Language)(0),               // 0: book_management_system.v1.Language
-	(*PutBookRequest)(nil),      // 1: book_management_system.v1.PutBookRequest
-	(*PutBookResponse)(nil),     // 2: book_management_system.v1.PutBookResponse
-	(*GetBookRequest)(nil),      // 3: book_management_system.v1.GetBookRequest
-	(*GetBookResponse)(nil),     // 4: book_management_system.v1.GetBookResponse
-	(*GetAllBooksRequest)(nil),  // 5: book_management_system.v1.GetAllBooksRequest
-	(*GetAllBooksResponse)(nil), // 6: book_management_system.v1.GetAllBooksResponse
-	(*SearchBookRequest)(nil),   // 7: book_management_system.v1.SearchBookRequest
-	(*SearchBookResponse)(nil),  // 8: book_management_system.v1.SearchBookResponse
-	(*Book)(nil),                // 9: book_management_system.v1.Book
-	(*RenameBookRequest)(nil),   // 10: book_management_system.v1.RenameBookRequest
-	(*RenameBookResponse)(nil),  // 11: book_management_system.v1.RenameBookResponse
-	(*DeleteBookRequest)(nil),   // 12: book_management_system.v1.DeleteBookRequest
-	(*DeleteBookResponse)(nil),  // 13: book_management_system.v1.DeleteBookResponse
+	(ImageOrigin)(0),                        // 0: book_management_system.v1.ImageOrigin
+	(AttachmentKind)(0),                     // 1: book_management_system.v1.AttachmentKind
+	(DatePrecision)(0),                      // 2: book_management_system.v1.DatePrecision
+	(ContributorRole)(0),                    // 3: book_management_system.v1.ContributorRole
+	(IdentifierType)(0),                     // 4: book_management_system.v1.IdentifierType
+	(Language)(0),                           // 5: book_management_system.v1.Language
+	(*PutBookRequest)(nil),                  // 6: book_management_system.v1.PutBookRequest
+	(*PutBookResponse)(nil),                 // 7: book_management_system.v1.PutBookResponse
+	(*CreateBookRequest)(nil),               // 8: book_management_system.v1.CreateBookRequest
+	(*CreateBookResponse)(nil),              // 9: book_management_system.v1.CreateBookResponse
+	(*GetBookRequest)(nil),                  // 10: book_management_system.v1.GetBookRequest
+	(*GetBookResponse)(nil),                 // 11: book_management_system.v1.GetBookResponse
+	(*GetAllBooksRequest)(nil),              // 12: book_management_system.v1.GetAllBooksRequest
+	(*GetAllBooksResponse)(nil),             // 13: book_management_system.v1.GetAllBooksResponse
+	(*SearchBookRequest)(nil),               // 14: book_management_system.v1.SearchBookRequest
+	(*SearchBookResponse)(nil),              // 15: book_management_system.v1.SearchBookResponse
+	(*Book)(nil),                            // 16: book_management_system.v1.Book
+	(*Contributor)(nil),                     // 17: book_management_system.v1.Contributor
+	(*Price)(nil),                           // 18: book_management_system.v1.Price
+	(*Identifier)(nil),                      // 19: book_management_system.v1.Identifier
+	(*RenameBookRequest)(nil),               // 20: book_management_system.v1.RenameBookRequest
+	(*RenameBookResponse)(nil),              // 21: book_management_system.v1.RenameBookResponse
+	(*DeleteBookRequest)(nil),               // 22: book_management_system.v1.DeleteBookRequest
+	(*DeleteBookResponse)(nil),              // 23: book_management_system.v1.DeleteBookResponse
+	(*UploadCoverRequest)(nil),              // 24: book_management_system.v1.UploadCoverRequest
+	(*UploadCoverResponse)(nil),             // 25: book_management_system.v1.UploadCoverResponse
+	(*Attachment)(nil),                      // 26: book_management_system.v1.Attachment
+	(*UploadAttachmentRequest)(nil),         // 27: book_management_system.v1.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),        // 28: book_management_system.v1.UploadAttachmentResponse
+	(*ListAttachmentsRequest)(nil),          // 29: book_management_system.v1.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),         // 30: book_management_system.v1.ListAttachmentsResponse
+	(*DeleteAttachmentRequest)(nil),         // 31: book_management_system.v1.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),        // 32: book_management_system.v1.DeleteAttachmentResponse
+	(*ReorderAttachmentsRequest)(nil),       // 33: book_management_system.v1.ReorderAttachmentsRequest
+	(*ReorderAttachmentsResponse)(nil),      // 34: book_management_system.v1.ReorderAttachmentsResponse
+	(*ListCoverCandidatesRequest)(nil),      // 35: book_management_system.v1.ListCoverCandidatesRequest
+	(*ListCoverCandidatesResponse)(nil),     // 36: book_management_system.v1.ListCoverCandidatesResponse
+	(*CoverCandidate)(nil),                  // 37: book_management_system.v1.CoverCandidate
+	(*SelectCoverRequest)(nil),              // 38: book_management_system.v1.SelectCoverRequest
+	(*SelectCoverResponse)(nil),             // 39: book_management_system.v1.SelectCoverResponse
+	(*SearchCatalogRequest)(nil),            // 40: book_management_system.v1.SearchCatalogRequest
+	(*SearchCatalogResponse)(nil),           // 41: book_management_system.v1.SearchCatalogResponse
+	(*CatalogCandidate)(nil),                // 42: book_management_system.v1.CatalogCandidate
+	(*ProviderCacheEntry)(nil),              // 43: book_management_system.v1.ProviderCacheEntry
+	(*ListProviderCacheRequest)(nil),        // 44: book_management_system.v1.ListProviderCacheRequest
+	(*ListProviderCacheResponse)(nil),       // 45: book_management_system.v1.ListProviderCacheResponse
+	(*InvalidateProviderCacheRequest)(nil),  // 46: book_management_system.v1.InvalidateProviderCacheRequest
+	(*InvalidateProviderCacheResponse)(nil), // 47: book_management_system.v1.InvalidateProviderCacheResponse
+	(*Author)(nil),                          // 48: book_management_system.v1.Author
+	(*ListAuthorsRequest)(nil),              // 49: book_management_system.v1.ListAuthorsRequest
+	(*ListAuthorsResponse)(nil),             // 50: book_management_system.v1.ListAuthorsResponse
+	(*ListBooksByAuthorRequest)(nil),        // 51: book_management_system.v1.ListBooksByAuthorRequest
+	(*ListBooksByAuthorResponse)(nil),       // 52: book_management_system.v1.ListBooksByAuthorResponse
+	(*UpdateAuthorRequest)(nil),             // 53: book_management_system.v1.UpdateAuthorRequest
+	(*UpdateAuthorResponse)(nil),            // 54: book_management_system.v1.UpdateAuthorResponse
+	(*MergeAuthorsRequest)(nil),             // 55: book_management_system.v1.MergeAuthorsRequest
+	(*MergeAuthorsResponse)(nil),            // 56: book_management_system.v1.MergeAuthorsResponse
+	(*BrowseClassificationRequest)(nil),     // 57: book_management_system.v1.BrowseClassificationRequest
+	(*BrowseClassificationResponse)(nil),    // 58: book_management_system.v1.BrowseClassificationResponse
+	(*ClassificationNode)(nil),              // 59: book_management_system.v1.ClassificationNode
 }
 var file_book_management_system_v1_book_proto_depIdxs = []int32{
-	9,  // 0: book_management_system.v1.PutBookResponse.book:type_name -> book_management_system.v1.Book
-	9,  // 1: book_management_system.v1.GetBookResponse.book:type_name -> book_management_system.v1.Book
-	9,  // 2: book_management_system.v1.GetAllBooksResponse.books:type_name -> book_management_system.v1.Book
-	9,  // 3: book_management_system.v1.SearchBookResponse.books:type_name -> book_management_system.v1.Book
-	0,  // 4: book_management_system.v1.Book.language:type_name -> book_management_system.v1.Language
-	1,  // 5: book_management_system.v1.BookManagementService.PutBook:input_type -> book_management_system.v1.PutBookRequest
-	3,  // 6: book_management_system.v1.BookManagementService.GetBook:input_type -> book_management_system.v1.GetBookRequest
-	5,  // 7: book_management_system.v1.BookManagementService.GetAllBooks:input_type -> book_management_system.v1.GetAllBooksRequest
-	7,  // 8: book_management_system.v1.BookManagementService.SearchBook:input_type -> book_management_system.v1.SearchBookRequest
-	10, // 9: book_management_system.v1.BookManagementService.RenameBook:input_type -> book_management_system.v1.RenameBookRequest
-	12, // 10: book_management_system.v1.BookManagementService.DeleteBook:input_type -> book_management_system.v1.DeleteBookRequest
-	2,  // 11: book_management_system.v1.BookManagementService.PutBook:output_type -> book_management_system.v1.PutBookResponse
-	4,  // 12: book_management_system.v1.BookManagementService.GetBook:output_type -> book_management_system.v1.GetBookResponse
-	6,  // 13: book_management_system.v1.BookManagementService.GetAllBooks:output_type -> book_management_system.v1.GetAllBooksResponse
-	8,  // 14: book_management_system.v1.BookManagementService.SearchBook:output_type -> book_management_system.v1.SearchBookResponse
-	11, // 15: book_management_system.v1.BookManagementService.RenameBook:output_type -> book_management_system.v1.RenameBookResponse
-	13, // 16: book_management_system.v1.BookManagementService.DeleteBook:output_type -> book_management_system.v1.DeleteBookResponse
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	19, // 0: book_management_system.v1.PutBookRequest.identifier:type_name -> book_management_system.v1.Identifier
+	16, // 1: book_management_system.v1.PutBookResponse.book:type_name -> book_management_system.v1.Book
+	16, // 2: book_management_system.v1.CreateBookRequest.book:type_name -> book_management_system.v1.Book
+	16, // 3: book_management_system.v1.CreateBookResponse.book:type_name -> book_management_system.v1.Book
+	16, // 4: book_management_system.v1.GetBookResponse.book:type_name -> book_management_system.v1.Book
+	16, // 5: book_management_system.v1.GetAllBooksResponse.books:type_name -> book_management_system.v1.Book
+	16, // 6: book_management_system.v1.SearchBookResponse.books:type_name -> book_management_system.v1.Book
+	5,  // 7: book_management_system.v1.Book.language:type_name -> book_management_system.v1.Language
+	19, // 8: book_management_system.v1.Book.identifiers:type_name -> book_management_system.v1.Identifier
+	18, // 9: book_management_system.v1.Book.price:type_name -> book_management_system.v1.Price
+	17, // 10: book_management_system.v1.Book.contributors:type_name -> book_management_system.v1.Contributor
+	2,  // 11: book_management_system.v1.Book.publishdate_precision:type_name -> book_management_system.v1.DatePrecision
+	0,  // 12: book_management_system.v1.Book.image_origin:type_name -> book_management_system.v1.ImageOrigin
+	3,  // 13: book_management_system.v1.Contributor.role:type_name -> book_management_system.v1.ContributorRole
+	4,  // 14: book_management_system.v1.Identifier.type:type_name -> book_management_system.v1.IdentifierType
+	16, // 15: book_management_system.v1.UploadCoverResponse.book:type_name -> book_management_system.v1.Book
+	1,  // 16: book_management_system.v1.Attachment.kind:type_name -> book_management_system.v1.AttachmentKind
+	1,  // 17: book_management_system.v1.UploadAttachmentRequest.kind:type_name -> book_management_system.v1.AttachmentKind
+	26, // 18: book_management_system.v1.UploadAttachmentResponse.attachment:type_name -> book_management_system.v1.Attachment
+	26, // 19: book_management_system.v1.ListAttachmentsResponse.attachments:type_name -> book_management_system.v1.Attachment
+	26, // 20: book_management_system.v1.ReorderAttachmentsResponse.attachments:type_name -> book_management_system.v1.Attachment
+	37, // 21: book_management_system.v1.ListCoverCandidatesResponse.candidates:type_name -> book_management_system.v1.CoverCandidate
+	16, // 22: book_management_system.v1.SelectCoverResponse.book:type_name -> book_management_system.v1.Book
+	42, // 23: book_management_system.v1.SearchCatalogResponse.candidates:type_name -> book_management_system.v1.CatalogCandidate
+	16, // 24: book_management_system.v1.CatalogCandidate.book:type_name -> book_management_system.v1.Book
+	43, // 25: book_management_system.v1.ListProviderCacheResponse.entries:type_name -> book_management_system.v1.ProviderCacheEntry
+	48, // 26: book_management_system.v1.ListAuthorsResponse.authors:type_name -> book_management_system.v1.Author
+	48, // 27: book_management_system.v1.ListBooksByAuthorResponse.author:type_name -> book_management_system.v1.Author
+	16, // 28: book_management_system.v1.ListBooksByAuthorResponse.books:type_name -> book_management_system.v1.Book
+	48, // 29: book_management_system.v1.UpdateAuthorRequest.author:type_name -> book_management_system.v1.Author
+	48, // 30: book_management_system.v1.UpdateAuthorResponse.author:type_name -> book_management_system.v1.Author
+	48, // 31: book_management_system.v1.MergeAuthorsResponse.author:type_name -> book_management_system.v1.Author
+	59, // 32: book_management_system.v1.BrowseClassificationResponse.nodes:type_name -> book_management_system.v1.ClassificationNode
+	16, // 33: book_management_system.v1.BrowseClassificationResponse.books:type_name -> book_management_system.v1.Book
+	6,  // 34: book_management_system.v1.BookManagementService.PutBook:input_type -> book_management_system.v1.PutBookRequest
+	8,  // 35: book_management_system.v1.BookManagementService.CreateBook:input_type -> book_management_system.v1.CreateBookRequest
+	10, // 36: book_management_system.v1.BookManagementService.GetBook:input_type -> book_management_system.v1.GetBookRequest
+	12, // 37: book_management_system.v1.BookManagementService.GetAllBooks:input_type -> book_management_system.v1.GetAllBooksRequest
+	14, // 38: book_management_system.v1.BookManagementService.SearchBook:input_type -> book_management_system.v1.SearchBookRequest
+	20, // 39: book_management_system.v1.BookManagementService.RenameBook:input_type -> book_management_system.v1.RenameBookRequest
+	22, // 40: book_management_system.v1.BookManagementService.DeleteBook:input_type -> book_management_system.v1.DeleteBookRequest
+	40, // 41: book_management_system.v1.BookManagementService.SearchCatalog:input_type -> book_management_system.v1.SearchCatalogRequest
+	24, // 42: book_management_system.v1.BookManagementService.UploadCover:input_type -> book_management_system.v1.UploadCoverRequest
+	35, // 43: book_management_system.v1.BookManagementService.ListCoverCandidates:input_type -> book_management_system.v1.ListCoverCandidatesRequest
+	38, // 44: book_management_system.v1.BookManagementService.SelectCover:input_type -> book_management_system.v1.SelectCoverRequest
+	27, // 45: book_management_system.v1.BookManagementService.UploadAttachment:input_type -> book_management_system.v1.UploadAttachmentRequest
+	29, // 46: book_management_system.v1.BookManagementService.ListAttachments:input_type -> book_management_system.v1.ListAttachmentsRequest
+	31, // 47: book_management_system.v1.BookManagementService.DeleteAttachment:input_type -> book_management_system.v1.DeleteAttachmentRequest
+	33, // 48: book_management_system.v1.BookManagementService.ReorderAttachments:input_type -> book_management_system.v1.ReorderAttachmentsRequest
+	49, // 49: book_management_system.v1.BookManagementService.ListAuthors:input_type -> book_management_system.v1.ListAuthorsRequest
+	51, // 50: book_management_system.v1.BookManagementService.ListBooksByAuthor:input_type -> book_management_system.v1.ListBooksByAuthorRequest
+	53, // 51: book_management_system.v1.BookManagementService.UpdateAuthor:input_type -> book_management_system.v1.UpdateAuthorRequest
+	55, // 52: book_management_system.v1.BookManagementService.MergeAuthors:input_type -> book_management_system.v1.MergeAuthorsRequest
+	57, // 53: book_management_system.v1.BookManagementService.BrowseClassification:input_type -> book_management_system.v1.BrowseClassificationRequest
+	44, // 54: book_management_system.v1.BookManagementService.ListProviderCache:input_type -> book_management_system.v1.ListProviderCacheRequest
+	46, // 55: book_management_system.v1.BookManagementService.InvalidateProviderCache:input_type -> book_management_system.v1.InvalidateProviderCacheRequest
+	7,  // 56: book_management_system.v1.BookManagementService.PutBook:output_type -> book_management_system.v1.PutBookResponse
+	9,  // 57: book_management_system.v1.BookManagementService.CreateBook:output_type -> book_management_system.v1.CreateBookResponse
+	11, // 58: book_management_system.v1.BookManagementService.GetBook:output_type -> book_management_system.v1.GetBookResponse
+	13, // 59: book_management_system.v1.BookManagementService.GetAllBooks:output_type -> book_management_system.v1.GetAllBooksResponse
+	15, // 60: book_management_system.v1.BookManagementService.SearchBook:output_type -> book_management_system.v1.SearchBookResponse
+	21, // 61: book_management_system.v1.BookManagementService.RenameBook:output_type -> book_management_system.v1.RenameBookResponse
+	23, // 62: book_management_system.v1.BookManagementService.DeleteBook:output_type -> book_management_system.v1.DeleteBookResponse
+	41, // 63: book_management_system.v1.BookManagementService.SearchCatalog:output_type -> book_management_system.v1.SearchCatalogResponse
+	25, // 64: book_management_system.v1.BookManagementService.UploadCover:output_type -> book_management_system.v1.UploadCoverResponse
+	36, // 65: book_management_system.v1.BookManagementService.ListCoverCandidates:output_type -> book_management_system.v1.ListCoverCandidatesResponse
+	39, // 66: book_management_system.v1.BookManagementService.SelectCover:output_type -> book_management_system.v1.SelectCoverResponse
+	28, // 67: book_management_system.v1.BookManagementService.UploadAttachment:output_type -> book_management_system.v1.UploadAttachmentResponse
+	30, // 68: book_management_system.v1.BookManagementService.ListAttachments:output_type -> book_management_system.v1.ListAttachmentsResponse
+	32, // 69: book_management_system.v1.BookManagementService.DeleteAttachment:output_type -> book_management_system.v1.DeleteAttachmentResponse
+	34, // 70: book_management_system.v1.BookManagementService.ReorderAttachments:output_type -> book_management_system.v1.ReorderAttachmentsResponse
+	50, // 71: book_management_system.v1.BookManagementService.ListAuthors:output_type -> book_management_system.v1.ListAuthorsResponse
+	52, // 72: book_management_system.v1.BookManagementService.ListBooksByAuthor:output_type -> book_management_system.v1.ListBooksByAuthorResponse
+	54, // 73: book_management_system.v1.BookManagementService.UpdateAuthor:output_type -> book_management_system.v1.UpdateAuthorResponse
+	56, // 74: book_management_system.v1.BookManagementService.MergeAuthors:output_type -> book_management_system.v1.MergeAuthorsResponse
+	58, // 75: book_management_system.v1.BookManagementService.BrowseClassification:output_type -> book_management_system.v1.BrowseClassificationResponse
+	45, // 76: book_management_system.v1.BookManagementService.ListProviderCache:output_type -> book_management_system.v1.ListProviderCacheResponse
+	47, // 77: book_management_system.v1.BookManagementService.InvalidateProviderCache:output_type -> book_management_system.v1.InvalidateProviderCacheResponse
+	56, // [56:78] is the sub-list for method output_type
+	34, // [34:56] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_book_management_system_v1_book_proto_init() }
@@ -790,8 +3788,8 @@ func file_book_management_system_v1_book_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_book_management_system_v1_book_proto_rawDesc), len(file_book_management_system_v1_book_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   13,
+			NumEnums:      6,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
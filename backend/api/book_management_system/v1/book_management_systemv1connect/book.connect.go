@@ -36,6 +36,9 @@ const (
 	// BookManagementServicePutBookProcedure is the fully-qualified name of the BookManagementService's
 	// PutBook RPC.
 	BookManagementServicePutBookProcedure = "/book_management_system.v1.BookManagementService/PutBook"
+	// BookManagementServiceCreateBookProcedure is the fully-qualified name of the
+	// BookManagementService's CreateBook RPC.
+	BookManagementServiceCreateBookProcedure = "/book_management_system.v1.BookManagementService/CreateBook"
 	// BookManagementServiceGetBookProcedure is the fully-qualified name of the BookManagementService's
 	// GetBook RPC.
 	BookManagementServiceGetBookProcedure = "/book_management_system.v1.BookManagementService/GetBook"
@@ -51,17 +54,78 @@ const (
 	// BookManagementServiceDeleteBookProcedure is the fully-qualified name of the
 	// BookManagementService's DeleteBook RPC.
 	BookManagementServiceDeleteBookProcedure = "/book_management_system.v1.BookManagementService/DeleteBook"
+	// BookManagementServiceSearchCatalogProcedure is the fully-qualified name of the
+	// BookManagementService's SearchCatalog RPC.
+	BookManagementServiceSearchCatalogProcedure = "/book_management_system.v1.BookManagementService/SearchCatalog"
+	// BookManagementServiceUploadCoverProcedure is the fully-qualified name of the
+	// BookManagementService's UploadCover RPC.
+	BookManagementServiceUploadCoverProcedure = "/book_management_system.v1.BookManagementService/UploadCover"
+	// BookManagementServiceListCoverCandidatesProcedure is the fully-qualified name of the
+	// BookManagementService's ListCoverCandidates RPC.
+	BookManagementServiceListCoverCandidatesProcedure = "/book_management_system.v1.BookManagementService/ListCoverCandidates"
+	// BookManagementServiceSelectCoverProcedure is the fully-qualified name of the
+	// BookManagementService's SelectCover RPC.
+	BookManagementServiceSelectCoverProcedure = "/book_management_system.v1.BookManagementService/SelectCover"
+	// BookManagementServiceUploadAttachmentProcedure is the fully-qualified name of the
+	// BookManagementService's UploadAttachment RPC.
+	BookManagementServiceUploadAttachmentProcedure = "/book_management_system.v1.BookManagementService/UploadAttachment"
+	// BookManagementServiceListAttachmentsProcedure is the fully-qualified name of the
+	// BookManagementService's ListAttachments RPC.
+	BookManagementServiceListAttachmentsProcedure = "/book_management_system.v1.BookManagementService/ListAttachments"
+	// BookManagementServiceDeleteAttachmentProcedure is the fully-qualified name of the
+	// BookManagementService's DeleteAttachment RPC.
+	BookManagementServiceDeleteAttachmentProcedure = "/book_management_system.v1.BookManagementService/DeleteAttachment"
+	// BookManagementServiceReorderAttachmentsProcedure is the fully-qualified name of the
+	// BookManagementService's ReorderAttachments RPC.
+	BookManagementServiceReorderAttachmentsProcedure = "/book_management_system.v1.BookManagementService/ReorderAttachments"
+	// BookManagementServiceListAuthorsProcedure is the fully-qualified name of the
+	// BookManagementService's ListAuthors RPC.
+	BookManagementServiceListAuthorsProcedure = "/book_management_system.v1.BookManagementService/ListAuthors"
+	// BookManagementServiceListBooksByAuthorProcedure is the fully-qualified name of the
+	// BookManagementService's ListBooksByAuthor RPC.
+	BookManagementServiceListBooksByAuthorProcedure = "/book_management_system.v1.BookManagementService/ListBooksByAuthor"
+	// BookManagementServiceUpdateAuthorProcedure is the fully-qualified name of the
+	// BookManagementService's UpdateAuthor RPC.
+	BookManagementServiceUpdateAuthorProcedure = "/book_management_system.v1.BookManagementService/UpdateAuthor"
+	// BookManagementServiceMergeAuthorsProcedure is the fully-qualified name of the
+	// BookManagementService's MergeAuthors RPC.
+	BookManagementServiceMergeAuthorsProcedure = "/book_management_system.v1.BookManagementService/MergeAuthors"
+	// BookManagementServiceBrowseClassificationProcedure is the fully-qualified name of the
+	// BookManagementService's BrowseClassification RPC.
+	BookManagementServiceBrowseClassificationProcedure = "/book_management_system.v1.BookManagementService/BrowseClassification"
+	// BookManagementServiceListProviderCacheProcedure is the fully-qualified name of the
+	// BookManagementService's ListProviderCache RPC.
+	BookManagementServiceListProviderCacheProcedure = "/book_management_system.v1.BookManagementService/ListProviderCache"
+	// BookManagementServiceInvalidateProviderCacheProcedure is the fully-qualified name of the
+	// BookManagementService's InvalidateProviderCache RPC.
+	BookManagementServiceInvalidateProviderCacheProcedure = "/book_management_system.v1.BookManagementService/InvalidateProviderCache"
 )
 
 // BookManagementServiceClient is a client for the book_management_system.v1.BookManagementService
 // service.
 type BookManagementServiceClient interface {
 	PutBook(context.Context, *connect.Request[v1.PutBookRequest]) (*connect.Response[v1.PutBookResponse], error)
+	CreateBook(context.Context, *connect.Request[v1.CreateBookRequest]) (*connect.Response[v1.CreateBookResponse], error)
 	GetBook(context.Context, *connect.Request[v1.GetBookRequest]) (*connect.Response[v1.GetBookResponse], error)
 	GetAllBooks(context.Context, *connect.Request[v1.GetAllBooksRequest]) (*connect.Response[v1.GetAllBooksResponse], error)
 	SearchBook(context.Context, *connect.Request[v1.SearchBookRequest]) (*connect.Response[v1.SearchBookResponse], error)
 	RenameBook(context.Context, *connect.Request[v1.RenameBookRequest]) (*connect.Response[v1.RenameBookResponse], error)
 	DeleteBook(context.Context, *connect.Request[v1.DeleteBookRequest]) (*connect.Response[v1.DeleteBookResponse], error)
+	SearchCatalog(context.Context, *connect.Request[v1.SearchCatalogRequest]) (*connect.Response[v1.SearchCatalogResponse], error)
+	UploadCover(context.Context) *connect.ClientStreamForClient[v1.UploadCoverRequest, v1.UploadCoverResponse]
+	ListCoverCandidates(context.Context, *connect.Request[v1.ListCoverCandidatesRequest]) (*connect.Response[v1.ListCoverCandidatesResponse], error)
+	SelectCover(context.Context, *connect.Request[v1.SelectCoverRequest]) (*connect.Response[v1.SelectCoverResponse], error)
+	UploadAttachment(context.Context) *connect.ClientStreamForClient[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse]
+	ListAttachments(context.Context, *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error)
+	DeleteAttachment(context.Context, *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[v1.DeleteAttachmentResponse], error)
+	ReorderAttachments(context.Context, *connect.Request[v1.ReorderAttachmentsRequest]) (*connect.Response[v1.ReorderAttachmentsResponse], error)
+	ListAuthors(context.Context, *connect.Request[v1.ListAuthorsRequest]) (*connect.Response[v1.ListAuthorsResponse], error)
+	ListBooksByAuthor(context.Context, *connect.Request[v1.ListBooksByAuthorRequest]) (*connect.Response[v1.ListBooksByAuthorResponse], error)
+	UpdateAuthor(context.Context, *connect.Request[v1.UpdateAuthorRequest]) (*connect.Response[v1.UpdateAuthorResponse], error)
+	MergeAuthors(context.Context, *connect.Request[v1.MergeAuthorsRequest]) (*connect.Response[v1.MergeAuthorsResponse], error)
+	BrowseClassification(context.Context, *connect.Request[v1.BrowseClassificationRequest]) (*connect.Response[v1.BrowseClassificationResponse], error)
+	ListProviderCache(context.Context, *connect.Request[v1.ListProviderCacheRequest]) (*connect.Response[v1.ListProviderCacheResponse], error)
+	InvalidateProviderCache(context.Context, *connect.Request[v1.InvalidateProviderCacheRequest]) (*connect.Response[v1.InvalidateProviderCacheResponse], error)
 }
 
 // NewBookManagementServiceClient constructs a client for the
@@ -82,6 +146,12 @@ func NewBookManagementServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(bookManagementServiceMethods.ByName("PutBook")),
 			connect.WithClientOptions(opts...),
 		),
+		createBook: connect.NewClient[v1.CreateBookRequest, v1.CreateBookResponse](
+			httpClient,
+			baseURL+BookManagementServiceCreateBookProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("CreateBook")),
+			connect.WithClientOptions(opts...),
+		),
 		getBook: connect.NewClient[v1.GetBookRequest, v1.GetBookResponse](
 			httpClient,
 			baseURL+BookManagementServiceGetBookProcedure,
@@ -112,17 +182,123 @@ func NewBookManagementServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(bookManagementServiceMethods.ByName("DeleteBook")),
 			connect.WithClientOptions(opts...),
 		),
+		searchCatalog: connect.NewClient[v1.SearchCatalogRequest, v1.SearchCatalogResponse](
+			httpClient,
+			baseURL+BookManagementServiceSearchCatalogProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("SearchCatalog")),
+			connect.WithClientOptions(opts...),
+		),
+		uploadCover: connect.NewClient[v1.UploadCoverRequest, v1.UploadCoverResponse](
+			httpClient,
+			baseURL+BookManagementServiceUploadCoverProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("UploadCover")),
+			connect.WithClientOptions(opts...),
+		),
+		listCoverCandidates: connect.NewClient[v1.ListCoverCandidatesRequest, v1.ListCoverCandidatesResponse](
+			httpClient,
+			baseURL+BookManagementServiceListCoverCandidatesProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("ListCoverCandidates")),
+			connect.WithClientOptions(opts...),
+		),
+		selectCover: connect.NewClient[v1.SelectCoverRequest, v1.SelectCoverResponse](
+			httpClient,
+			baseURL+BookManagementServiceSelectCoverProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("SelectCover")),
+			connect.WithClientOptions(opts...),
+		),
+		uploadAttachment: connect.NewClient[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse](
+			httpClient,
+			baseURL+BookManagementServiceUploadAttachmentProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("UploadAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		listAttachments: connect.NewClient[v1.ListAttachmentsRequest, v1.ListAttachmentsResponse](
+			httpClient,
+			baseURL+BookManagementServiceListAttachmentsProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("ListAttachments")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAttachment: connect.NewClient[v1.DeleteAttachmentRequest, v1.DeleteAttachmentResponse](
+			httpClient,
+			baseURL+BookManagementServiceDeleteAttachmentProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("DeleteAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderAttachments: connect.NewClient[v1.ReorderAttachmentsRequest, v1.ReorderAttachmentsResponse](
+			httpClient,
+			baseURL+BookManagementServiceReorderAttachmentsProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("ReorderAttachments")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuthors: connect.NewClient[v1.ListAuthorsRequest, v1.ListAuthorsResponse](
+			httpClient,
+			baseURL+BookManagementServiceListAuthorsProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("ListAuthors")),
+			connect.WithClientOptions(opts...),
+		),
+		listBooksByAuthor: connect.NewClient[v1.ListBooksByAuthorRequest, v1.ListBooksByAuthorResponse](
+			httpClient,
+			baseURL+BookManagementServiceListBooksByAuthorProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("ListBooksByAuthor")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAuthor: connect.NewClient[v1.UpdateAuthorRequest, v1.UpdateAuthorResponse](
+			httpClient,
+			baseURL+BookManagementServiceUpdateAuthorProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("UpdateAuthor")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeAuthors: connect.NewClient[v1.MergeAuthorsRequest, v1.MergeAuthorsResponse](
+			httpClient,
+			baseURL+BookManagementServiceMergeAuthorsProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("MergeAuthors")),
+			connect.WithClientOptions(opts...),
+		),
+		browseClassification: connect.NewClient[v1.BrowseClassificationRequest, v1.BrowseClassificationResponse](
+			httpClient,
+			baseURL+BookManagementServiceBrowseClassificationProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("BrowseClassification")),
+			connect.WithClientOptions(opts...),
+		),
+		listProviderCache: connect.NewClient[v1.ListProviderCacheRequest, v1.ListProviderCacheResponse](
+			httpClient,
+			baseURL+BookManagementServiceListProviderCacheProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("ListProviderCache")),
+			connect.WithClientOptions(opts...),
+		),
+		invalidateProviderCache: connect.NewClient[v1.InvalidateProviderCacheRequest, v1.InvalidateProviderCacheResponse](
+			httpClient,
+			baseURL+BookManagementServiceInvalidateProviderCacheProcedure,
+			connect.WithSchema(bookManagementServiceMethods.ByName("InvalidateProviderCache")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // bookManagementServiceClient implements BookManagementServiceClient.
 type bookManagementServiceClient struct {
-	putBook     *connect.Client[v1.PutBookRequest, v1.PutBookResponse]
-	getBook     *connect.Client[v1.GetBookRequest, v1.GetBookResponse]
-	getAllBooks *connect.Client[v1.GetAllBooksRequest, v1.GetAllBooksResponse]
-	searchBook  *connect.Client[v1.SearchBookRequest, v1.SearchBookResponse]
-	renameBook  *connect.Client[v1.RenameBookRequest, v1.RenameBookResponse]
-	deleteBook  *connect.Client[v1.DeleteBookRequest, v1.DeleteBookResponse]
+	putBook                 *connect.Client[v1.PutBookRequest, v1.PutBookResponse]
+	createBook              *connect.Client[v1.CreateBookRequest, v1.CreateBookResponse]
+	getBook                 *connect.Client[v1.GetBookRequest, v1.GetBookResponse]
+	getAllBooks             *connect.Client[v1.GetAllBooksRequest, v1.GetAllBooksResponse]
+	searchBook              *connect.Client[v1.SearchBookRequest, v1.SearchBookResponse]
+	renameBook              *connect.Client[v1.RenameBookRequest, v1.RenameBookResponse]
+	deleteBook              *connect.Client[v1.DeleteBookRequest, v1.DeleteBookResponse]
+	searchCatalog           *connect.Client[v1.SearchCatalogRequest, v1.SearchCatalogResponse]
+	uploadCover             *connect.Client[v1.UploadCoverRequest, v1.UploadCoverResponse]
+	listCoverCandidates     *connect.Client[v1.ListCoverCandidatesRequest, v1.ListCoverCandidatesResponse]
+	selectCover             *connect.Client[v1.SelectCoverRequest, v1.SelectCoverResponse]
+	uploadAttachment        *connect.Client[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse]
+	listAttachments         *connect.Client[v1.ListAttachmentsRequest, v1.ListAttachmentsResponse]
+	deleteAttachment        *connect.Client[v1.DeleteAttachmentRequest, v1.DeleteAttachmentResponse]
+	reorderAttachments      *connect.Client[v1.ReorderAttachmentsRequest, v1.ReorderAttachmentsResponse]
+	listAuthors             *connect.Client[v1.ListAuthorsRequest, v1.ListAuthorsResponse]
+	listBooksByAuthor       *connect.Client[v1.ListBooksByAuthorRequest, v1.ListBooksByAuthorResponse]
+	updateAuthor            *connect.Client[v1.UpdateAuthorRequest, v1.UpdateAuthorResponse]
+	mergeAuthors            *connect.Client[v1.MergeAuthorsRequest, v1.MergeAuthorsResponse]
+	browseClassification    *connect.Client[v1.BrowseClassificationRequest, v1.BrowseClassificationResponse]
+	listProviderCache       *connect.Client[v1.ListProviderCacheRequest, v1.ListProviderCacheResponse]
+	invalidateProviderCache *connect.Client[v1.InvalidateProviderCacheRequest, v1.InvalidateProviderCacheResponse]
 }
 
 // PutBook calls book_management_system.v1.BookManagementService.PutBook.
@@ -130,6 +306,11 @@ func (c *bookManagementServiceClient) PutBook(ctx context.Context, req *connect.
 	return c.putBook.CallUnary(ctx, req)
 }
 
+// CreateBook calls book_management_system.v1.BookManagementService.CreateBook.
+func (c *bookManagementServiceClient) CreateBook(ctx context.Context, req *connect.Request[v1.CreateBookRequest]) (*connect.Response[v1.CreateBookResponse], error) {
+	return c.createBook.CallUnary(ctx, req)
+}
+
 // GetBook calls book_management_system.v1.BookManagementService.GetBook.
 func (c *bookManagementServiceClient) GetBook(ctx context.Context, req *connect.Request[v1.GetBookRequest]) (*connect.Response[v1.GetBookResponse], error) {
 	return c.getBook.CallUnary(ctx, req)
@@ -155,15 +336,107 @@ func (c *bookManagementServiceClient) DeleteBook(ctx context.Context, req *conne
 	return c.deleteBook.CallUnary(ctx, req)
 }
 
+// SearchCatalog calls book_management_system.v1.BookManagementService.SearchCatalog.
+func (c *bookManagementServiceClient) SearchCatalog(ctx context.Context, req *connect.Request[v1.SearchCatalogRequest]) (*connect.Response[v1.SearchCatalogResponse], error) {
+	return c.searchCatalog.CallUnary(ctx, req)
+}
+
+// UploadCover calls book_management_system.v1.BookManagementService.UploadCover.
+func (c *bookManagementServiceClient) UploadCover(ctx context.Context) *connect.ClientStreamForClient[v1.UploadCoverRequest, v1.UploadCoverResponse] {
+	return c.uploadCover.CallClientStream(ctx)
+}
+
+// ListCoverCandidates calls book_management_system.v1.BookManagementService.ListCoverCandidates.
+func (c *bookManagementServiceClient) ListCoverCandidates(ctx context.Context, req *connect.Request[v1.ListCoverCandidatesRequest]) (*connect.Response[v1.ListCoverCandidatesResponse], error) {
+	return c.listCoverCandidates.CallUnary(ctx, req)
+}
+
+// SelectCover calls book_management_system.v1.BookManagementService.SelectCover.
+func (c *bookManagementServiceClient) SelectCover(ctx context.Context, req *connect.Request[v1.SelectCoverRequest]) (*connect.Response[v1.SelectCoverResponse], error) {
+	return c.selectCover.CallUnary(ctx, req)
+}
+
+// UploadAttachment calls book_management_system.v1.BookManagementService.UploadAttachment.
+func (c *bookManagementServiceClient) UploadAttachment(ctx context.Context) *connect.ClientStreamForClient[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse] {
+	return c.uploadAttachment.CallClientStream(ctx)
+}
+
+// ListAttachments calls book_management_system.v1.BookManagementService.ListAttachments.
+func (c *bookManagementServiceClient) ListAttachments(ctx context.Context, req *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error) {
+	return c.listAttachments.CallUnary(ctx, req)
+}
+
+// DeleteAttachment calls book_management_system.v1.BookManagementService.DeleteAttachment.
+func (c *bookManagementServiceClient) DeleteAttachment(ctx context.Context, req *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[v1.DeleteAttachmentResponse], error) {
+	return c.deleteAttachment.CallUnary(ctx, req)
+}
+
+// ReorderAttachments calls book_management_system.v1.BookManagementService.ReorderAttachments.
+func (c *bookManagementServiceClient) ReorderAttachments(ctx context.Context, req *connect.Request[v1.ReorderAttachmentsRequest]) (*connect.Response[v1.ReorderAttachmentsResponse], error) {
+	return c.reorderAttachments.CallUnary(ctx, req)
+}
+
+// ListAuthors calls book_management_system.v1.BookManagementService.ListAuthors.
+func (c *bookManagementServiceClient) ListAuthors(ctx context.Context, req *connect.Request[v1.ListAuthorsRequest]) (*connect.Response[v1.ListAuthorsResponse], error) {
+	return c.listAuthors.CallUnary(ctx, req)
+}
+
+// ListBooksByAuthor calls book_management_system.v1.BookManagementService.ListBooksByAuthor.
+func (c *bookManagementServiceClient) ListBooksByAuthor(ctx context.Context, req *connect.Request[v1.ListBooksByAuthorRequest]) (*connect.Response[v1.ListBooksByAuthorResponse], error) {
+	return c.listBooksByAuthor.CallUnary(ctx, req)
+}
+
+// UpdateAuthor calls book_management_system.v1.BookManagementService.UpdateAuthor.
+func (c *bookManagementServiceClient) UpdateAuthor(ctx context.Context, req *connect.Request[v1.UpdateAuthorRequest]) (*connect.Response[v1.UpdateAuthorResponse], error) {
+	return c.updateAuthor.CallUnary(ctx, req)
+}
+
+// MergeAuthors calls book_management_system.v1.BookManagementService.MergeAuthors.
+func (c *bookManagementServiceClient) MergeAuthors(ctx context.Context, req *connect.Request[v1.MergeAuthorsRequest]) (*connect.Response[v1.MergeAuthorsResponse], error) {
+	return c.mergeAuthors.CallUnary(ctx, req)
+}
+
+// BrowseClassification calls book_management_system.v1.BookManagementService.BrowseClassification.
+func (c *bookManagementServiceClient) BrowseClassification(ctx context.Context, req *connect.Request[v1.BrowseClassificationRequest]) (*connect.Response[v1.BrowseClassificationResponse], error) {
+	return c.browseClassification.CallUnary(ctx, req)
+}
+
+// ListProviderCache calls book_management_system.v1.BookManagementService.ListProviderCache.
+func (c *bookManagementServiceClient) ListProviderCache(ctx context.Context, req *connect.Request[v1.ListProviderCacheRequest]) (*connect.Response[v1.ListProviderCacheResponse], error) {
+	return c.listProviderCache.CallUnary(ctx, req)
+}
+
+// InvalidateProviderCache calls
+// book_management_system.v1.BookManagementService.InvalidateProviderCache.
+func (c *bookManagementServiceClient) InvalidateProviderCache(ctx context.Context, req *connect.Request[v1.InvalidateProviderCacheRequest]) (*connect.Response[v1.InvalidateProviderCacheResponse], error) {
+	return c.invalidateProviderCache.CallUnary(ctx, req)
+}
+
 // BookManagementServiceHandler is an implementation of the
 // book_management_system.v1.BookManagementService service.
 type BookManagementServiceHandler interface {
 	PutBook(context.Context, *connect.Request[v1.PutBookRequest]) (*connect.Response[v1.PutBookResponse], error)
+	CreateBook(context.Context, *connect.Request[v1.CreateBookRequest]) (*connect.Response[v1.CreateBookResponse], error)
 	GetBook(context.Context, *connect.Request[v1.GetBookRequest]) (*connect.Response[v1.GetBookResponse], error)
 	GetAllBooks(context.Context, *connect.Request[v1.GetAllBooksRequest]) (*connect.Response[v1.GetAllBooksResponse], error)
 	SearchBook(context.Context, *connect.Request[v1.SearchBookRequest]) (*connect.Response[v1.SearchBookResponse], error)
 	RenameBook(context.Context, *connect.Request[v1.RenameBookRequest]) (*connect.Response[v1.RenameBookResponse], error)
 	DeleteBook(context.Context, *connect.Request[v1.DeleteBookRequest]) (*connect.Response[v1.DeleteBookResponse], error)
+	SearchCatalog(context.Context, *connect.Request[v1.SearchCatalogRequest]) (*connect.Response[v1.SearchCatalogResponse], error)
+	UploadCover(context.Context, *connect.ClientStream[v1.UploadCoverRequest]) (*connect.Response[v1.UploadCoverResponse], error)
+	ListCoverCandidates(context.Context, *connect.Request[v1.ListCoverCandidatesRequest]) (*connect.Response[v1.ListCoverCandidatesResponse], error)
+	SelectCover(context.Context, *connect.Request[v1.SelectCoverRequest]) (*connect.Response[v1.SelectCoverResponse], error)
+	UploadAttachment(context.Context, *connect.ClientStream[v1.UploadAttachmentRequest]) (*connect.Response[v1.UploadAttachmentResponse], error)
+	ListAttachments(context.Context, *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error)
+	DeleteAttachment(context.Context, *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[v1.DeleteAttachmentResponse], error)
+	ReorderAttachments(context.Context, *connect.Request[v1.ReorderAttachmentsRequest]) (*connect.Response[v1.ReorderAttachmentsResponse], error)
+	ListAuthors(context.Context, *connect.Request[v1.ListAuthorsRequest]) (*connect.Response[v1.ListAuthorsResponse], error)
+	ListBooksByAuthor(context.Context, *connect.Request[v1.ListBooksByAuthorRequest]) (*connect.Response[v1.ListBooksByAuthorResponse], error)
+	UpdateAuthor(context.Context, *connect.Request[v1.UpdateAuthorRequest]) (*connect.Response[v1.UpdateAuthorResponse], error)
+	MergeAuthors(context.Context, *connect.Request[v1.MergeAuthorsRequest]) (*connect.Response[v1.MergeAuthorsResponse], error)
+	BrowseClassification(context.Context, *connect.Request[v1.BrowseClassificationRequest]) (*connect.Response[v1.BrowseClassificationResponse], error)
+	ListProviderCache(context.Context, *connect.Request[v1.ListProviderCacheRequest]) (*connect.Response[v1.ListProviderCacheResponse], error)
+	InvalidateProviderCache(context.Context, *connect.Request[v1.InvalidateProviderCacheRequest]) (*connect.Response[v1.InvalidateProviderCacheResponse], error)
 }
 
 // NewBookManagementServiceHandler builds an HTTP handler from the service implementation. It
@@ -179,6 +452,12 @@ func NewBookManagementServiceHandler(svc BookManagementServiceHandler, opts ...c
 		connect.WithSchema(bookManagementServiceMethods.ByName("PutBook")),
 		connect.WithHandlerOptions(opts...),
 	)
+	bookManagementServiceCreateBookHandler := connect.NewUnaryHandler(
+		BookManagementServiceCreateBookProcedure,
+		svc.CreateBook,
+		connect.WithSchema(bookManagementServiceMethods.ByName("CreateBook")),
+		connect.WithHandlerOptions(opts...),
+	)
 	bookManagementServiceGetBookHandler := connect.NewUnaryHandler(
 		BookManagementServiceGetBookProcedure,
 		svc.GetBook,
@@ -209,10 +488,102 @@ func NewBookManagementServiceHandler(svc BookManagementServiceHandler, opts ...c
 		connect.WithSchema(bookManagementServiceMethods.ByName("DeleteBook")),
 		connect.WithHandlerOptions(opts...),
 	)
+	bookManagementServiceSearchCatalogHandler := connect.NewUnaryHandler(
+		BookManagementServiceSearchCatalogProcedure,
+		svc.SearchCatalog,
+		connect.WithSchema(bookManagementServiceMethods.ByName("SearchCatalog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceUploadCoverHandler := connect.NewClientStreamHandler(
+		BookManagementServiceUploadCoverProcedure,
+		svc.UploadCover,
+		connect.WithSchema(bookManagementServiceMethods.ByName("UploadCover")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceListCoverCandidatesHandler := connect.NewUnaryHandler(
+		BookManagementServiceListCoverCandidatesProcedure,
+		svc.ListCoverCandidates,
+		connect.WithSchema(bookManagementServiceMethods.ByName("ListCoverCandidates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceSelectCoverHandler := connect.NewUnaryHandler(
+		BookManagementServiceSelectCoverProcedure,
+		svc.SelectCover,
+		connect.WithSchema(bookManagementServiceMethods.ByName("SelectCover")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceUploadAttachmentHandler := connect.NewClientStreamHandler(
+		BookManagementServiceUploadAttachmentProcedure,
+		svc.UploadAttachment,
+		connect.WithSchema(bookManagementServiceMethods.ByName("UploadAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceListAttachmentsHandler := connect.NewUnaryHandler(
+		BookManagementServiceListAttachmentsProcedure,
+		svc.ListAttachments,
+		connect.WithSchema(bookManagementServiceMethods.ByName("ListAttachments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceDeleteAttachmentHandler := connect.NewUnaryHandler(
+		BookManagementServiceDeleteAttachmentProcedure,
+		svc.DeleteAttachment,
+		connect.WithSchema(bookManagementServiceMethods.ByName("DeleteAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceReorderAttachmentsHandler := connect.NewUnaryHandler(
+		BookManagementServiceReorderAttachmentsProcedure,
+		svc.ReorderAttachments,
+		connect.WithSchema(bookManagementServiceMethods.ByName("ReorderAttachments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceListAuthorsHandler := connect.NewUnaryHandler(
+		BookManagementServiceListAuthorsProcedure,
+		svc.ListAuthors,
+		connect.WithSchema(bookManagementServiceMethods.ByName("ListAuthors")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceListBooksByAuthorHandler := connect.NewUnaryHandler(
+		BookManagementServiceListBooksByAuthorProcedure,
+		svc.ListBooksByAuthor,
+		connect.WithSchema(bookManagementServiceMethods.ByName("ListBooksByAuthor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceUpdateAuthorHandler := connect.NewUnaryHandler(
+		BookManagementServiceUpdateAuthorProcedure,
+		svc.UpdateAuthor,
+		connect.WithSchema(bookManagementServiceMethods.ByName("UpdateAuthor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceMergeAuthorsHandler := connect.NewUnaryHandler(
+		BookManagementServiceMergeAuthorsProcedure,
+		svc.MergeAuthors,
+		connect.WithSchema(bookManagementServiceMethods.ByName("MergeAuthors")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceBrowseClassificationHandler := connect.NewUnaryHandler(
+		BookManagementServiceBrowseClassificationProcedure,
+		svc.BrowseClassification,
+		connect.WithSchema(bookManagementServiceMethods.ByName("BrowseClassification")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceListProviderCacheHandler := connect.NewUnaryHandler(
+		BookManagementServiceListProviderCacheProcedure,
+		svc.ListProviderCache,
+		connect.WithSchema(bookManagementServiceMethods.ByName("ListProviderCache")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookManagementServiceInvalidateProviderCacheHandler := connect.NewUnaryHandler(
+		BookManagementServiceInvalidateProviderCacheProcedure,
+		svc.InvalidateProviderCache,
+		connect.WithSchema(bookManagementServiceMethods.ByName("InvalidateProviderCache")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/book_management_system.v1.BookManagementService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BookManagementServicePutBookProcedure:
 			bookManagementServicePutBookHandler.ServeHTTP(w, r)
+		case BookManagementServiceCreateBookProcedure:
+			bookManagementServiceCreateBookHandler.ServeHTTP(w, r)
 		case BookManagementServiceGetBookProcedure:
 			bookManagementServiceGetBookHandler.ServeHTTP(w, r)
 		case BookManagementServiceGetAllBooksProcedure:
@@ -223,6 +594,36 @@ func NewBookManagementServiceHandler(svc BookManagementServiceHandler, opts ...c
 			bookManagementServiceRenameBookHandler.ServeHTTP(w, r)
 		case BookManagementServiceDeleteBookProcedure:
 			bookManagementServiceDeleteBookHandler.ServeHTTP(w, r)
+		case BookManagementServiceSearchCatalogProcedure:
+			bookManagementServiceSearchCatalogHandler.ServeHTTP(w, r)
+		case BookManagementServiceUploadCoverProcedure:
+			bookManagementServiceUploadCoverHandler.ServeHTTP(w, r)
+		case BookManagementServiceListCoverCandidatesProcedure:
+			bookManagementServiceListCoverCandidatesHandler.ServeHTTP(w, r)
+		case BookManagementServiceSelectCoverProcedure:
+			bookManagementServiceSelectCoverHandler.ServeHTTP(w, r)
+		case BookManagementServiceUploadAttachmentProcedure:
+			bookManagementServiceUploadAttachmentHandler.ServeHTTP(w, r)
+		case BookManagementServiceListAttachmentsProcedure:
+			bookManagementServiceListAttachmentsHandler.ServeHTTP(w, r)
+		case BookManagementServiceDeleteAttachmentProcedure:
+			bookManagementServiceDeleteAttachmentHandler.ServeHTTP(w, r)
+		case BookManagementServiceReorderAttachmentsProcedure:
+			bookManagementServiceReorderAttachmentsHandler.ServeHTTP(w, r)
+		case BookManagementServiceListAuthorsProcedure:
+			bookManagementServiceListAuthorsHandler.ServeHTTP(w, r)
+		case BookManagementServiceListBooksByAuthorProcedure:
+			bookManagementServiceListBooksByAuthorHandler.ServeHTTP(w, r)
+		case BookManagementServiceUpdateAuthorProcedure:
+			bookManagementServiceUpdateAuthorHandler.ServeHTTP(w, r)
+		case BookManagementServiceMergeAuthorsProcedure:
+			bookManagementServiceMergeAuthorsHandler.ServeHTTP(w, r)
+		case BookManagementServiceBrowseClassificationProcedure:
+			bookManagementServiceBrowseClassificationHandler.ServeHTTP(w, r)
+		case BookManagementServiceListProviderCacheProcedure:
+			bookManagementServiceListProviderCacheHandler.ServeHTTP(w, r)
+		case BookManagementServiceInvalidateProviderCacheProcedure:
+			bookManagementServiceInvalidateProviderCacheHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -236,6 +637,10 @@ func (UnimplementedBookManagementServiceHandler) PutBook(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.PutBook is not implemented"))
 }
 
+func (UnimplementedBookManagementServiceHandler) CreateBook(context.Context, *connect.Request[v1.CreateBookRequest]) (*connect.Response[v1.CreateBookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.CreateBook is not implemented"))
+}
+
 func (UnimplementedBookManagementServiceHandler) GetBook(context.Context, *connect.Request[v1.GetBookRequest]) (*connect.Response[v1.GetBookResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.GetBook is not implemented"))
 }
@@ -255,3 +660,63 @@ func (UnimplementedBookManagementServiceHandler) RenameBook(context.Context, *co
 func (UnimplementedBookManagementServiceHandler) DeleteBook(context.Context, *connect.Request[v1.DeleteBookRequest]) (*connect.Response[v1.DeleteBookResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.DeleteBook is not implemented"))
 }
+
+func (UnimplementedBookManagementServiceHandler) SearchCatalog(context.Context, *connect.Request[v1.SearchCatalogRequest]) (*connect.Response[v1.SearchCatalogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.SearchCatalog is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) UploadCover(context.Context, *connect.ClientStream[v1.UploadCoverRequest]) (*connect.Response[v1.UploadCoverResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.UploadCover is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) ListCoverCandidates(context.Context, *connect.Request[v1.ListCoverCandidatesRequest]) (*connect.Response[v1.ListCoverCandidatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.ListCoverCandidates is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) SelectCover(context.Context, *connect.Request[v1.SelectCoverRequest]) (*connect.Response[v1.SelectCoverResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.SelectCover is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) UploadAttachment(context.Context, *connect.ClientStream[v1.UploadAttachmentRequest]) (*connect.Response[v1.UploadAttachmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.UploadAttachment is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) ListAttachments(context.Context, *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.ListAttachments is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) DeleteAttachment(context.Context, *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[v1.DeleteAttachmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.DeleteAttachment is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) ReorderAttachments(context.Context, *connect.Request[v1.ReorderAttachmentsRequest]) (*connect.Response[v1.ReorderAttachmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.ReorderAttachments is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) ListAuthors(context.Context, *connect.Request[v1.ListAuthorsRequest]) (*connect.Response[v1.ListAuthorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.ListAuthors is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) ListBooksByAuthor(context.Context, *connect.Request[v1.ListBooksByAuthorRequest]) (*connect.Response[v1.ListBooksByAuthorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.ListBooksByAuthor is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) UpdateAuthor(context.Context, *connect.Request[v1.UpdateAuthorRequest]) (*connect.Response[v1.UpdateAuthorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.UpdateAuthor is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) MergeAuthors(context.Context, *connect.Request[v1.MergeAuthorsRequest]) (*connect.Response[v1.MergeAuthorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.MergeAuthors is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) BrowseClassification(context.Context, *connect.Request[v1.BrowseClassificationRequest]) (*connect.Response[v1.BrowseClassificationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.BrowseClassification is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) ListProviderCache(context.Context, *connect.Request[v1.ListProviderCacheRequest]) (*connect.Response[v1.ListProviderCacheResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.ListProviderCache is not implemented"))
+}
+
+func (UnimplementedBookManagementServiceHandler) InvalidateProviderCache(context.Context, *connect.Request[v1.InvalidateProviderCacheRequest]) (*connect.Response[v1.InvalidateProviderCacheResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("book_management_system.v1.BookManagementService.InvalidateProviderCache is not implemented"))
+}
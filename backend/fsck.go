@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/nyahahanoha/BookManagementSystem/backend/pkg/config"
+	"github.com/nyahahanoha/BookManagementSystem/backend/pkg/store"
+)
+
+// runFsck は DB と ObjectStore の不整合を表示し、-repair の場合は直す
+// 不整合が残った場合は 1 を返す
+func runFsck(logger *slog.Logger, cfg config.Config, args []string) int {
+	flags := flag.NewFlagSet("fsck", flag.ContinueOnError)
+	repair := flags.Bool("repair", false, "delete orphan images and recreate missing covers and resized images")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	books, err := store.NewBooksStore(logger, cfg.StoreConfig)
+	if err != nil {
+		logger.Error("failed to create store", slog.String("err", err.Error()))
+		return 1
+	}
+	defer func() {
+		if err := books.Close(); err != nil {
+			logger.Error("failed to close store", slog.String("err", err.Error()))
+		}
+	}()
+
+	report, err := books.Fsck(*repair)
+	if err != nil {
+		logger.Error("failed to check store", slog.String("err", err.Error()))
+		return 1
+	}
+	for _, id := range report.OrphanImages {
+		fmt.Fprintf(os.Stdout, "orphan image: %s\n", id)
+	}
+	for _, id := range report.MissingImages {
+		fmt.Fprintf(os.Stdout, "missing image: %s\n", id)
+	}
+	for _, id := range report.MissingVariants {
+		fmt.Fprintf(os.Stdout, "missing resized images: %s\n", id)
+	}
+	for _, id := range report.MissingAttachments {
+		fmt.Fprintf(os.Stdout, "missing attachment: %s\n", id)
+	}
+	fmt.Fprintf(os.Stdout, "%d problems, %d repaired, %d failed\n", report.Problems(), report.Repaired, report.Failed)
+
+	if report.Failed > 0 || (!*repair && report.Problems() > 0) {
+		return 1
+	}
+	return 0
+}
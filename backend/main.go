@@ -7,7 +7,6 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
 
 	"connectrpc.com/connect"
@@ -34,6 +33,10 @@ func main() {
 
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 
+	if len(os.Args) > 1 && os.Args[1] == "fsck" {
+		os.Exit(runFsck(logger, cfg, os.Args[2:]))
+	}
+
 	books, err := service.NewBooksService(logger, cfg)
 	if err != nil {
 		log.Fatalf("failed to create service: %v", err)
@@ -53,12 +56,7 @@ func main() {
 	)
 	mux.Handle(path, handler)
 
-	mux.HandleFunc("/images/", func(w http.ResponseWriter, r *http.Request) {
-		if strings.HasPrefix(r.URL.Path, "/images/") {
-			filePath := "/var/lib/booksystem" + r.URL.Path[len("/images"):]
-			http.ServeFile(w, r, filePath)
-		}
-	})
+	mux.Handle("/images/", books.ImageHandler())
 
 	c := cors.New(cors.Options{
 		AllowedOrigins:     []string{cfg.FrontendURL}, // フロントのURL
@@ -2,11 +2,18 @@ package books
 
 import (
 	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
 
 	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
 	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+	booksfixture "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/fixture"
 	googlebooks "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/google"
+	httpbooks "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/http"
 	ndlbooks "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/ndl"
+	openbdbooks "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/openbd"
+	openlibrarybooks "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/openlibrary"
 )
 
 type Books interface {
@@ -14,25 +21,92 @@ type Books interface {
 	GetInfo(isbn string) (*bookscommon.Info, error)
 }
 
-func NewBooks(config booksconfig.Config) ([]Books, error) {
-	var booksList []Books
+// Searcher はキーワードでカタログを探せる Books
+// 対応していない場合は bookscommon.ErrNotSupported を返す
+type Searcher interface {
+	Search(query bookscommon.Query) ([]bookscommon.Info, error)
+}
+
+// IdentifierLookup は ISBN 以外の識別子で本を探せる Books
+// 対応していない識別子の場合は bookscommon.ErrNotSupported を返す
+type IdentifierLookup interface {
+	GetInfoByIdentifier(id bookscommon.Identifier) (*bookscommon.Info, error)
+}
+
+// Provider は Books とその設定上の種類の組
+// HTTP のように一つの種類から複数の Books が作られることがあるので Name で区別する
+type Provider struct {
+	Books
+	Kind booksconfig.BooksComponent
+	Name string
+	// New は HTTP の Transport を wrap で包んだ同じ設定の Books を作る
+	// プロバイダーの生のレスポンスをキャッシュするのに使う
+	New func(wrap func(http.RoundTripper) http.RoundTripper) (Books, error)
+}
+
+// NewBooks は全てのプロバイダーを作る
+// client が nil の場合は fixture の設定に従った client を使う
+func NewBooks(lg *slog.Logger, config booksconfig.Config, client *http.Client) ([]Provider, error) {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+		if config.Fixture.Mode != booksconfig.Off {
+			transport, err := booksfixture.NewTransport(config.Fixture, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create fixture transport: %w", err)
+			}
+			client.Transport = transport
+		}
+	}
+
+	var booksList []Provider
+	add := func(kind booksconfig.BooksComponent, name string, newBooks func(client *http.Client) (Books, error)) error {
+		books, err := newBooks(client)
+		if err != nil {
+			return fmt.Errorf("failed to create books: %w", err)
+		}
+		booksList = append(booksList, Provider{
+			Books: books,
+			Kind:  kind,
+			Name:  name,
+			New: func(wrap func(http.RoundTripper) http.RoundTripper) (Books, error) {
+				return newBooks(&http.Client{Transport: wrap(client.Transport), Timeout: client.Timeout})
+			},
+		})
+		return nil
+	}
 	for _, kind := range config.Kind {
+		var err error
 		switch kind {
 		case booksconfig.Google:
-			books, err := googlebooks.NewGoogleBooks(config.Google)
-			if err != nil {
-				return nil, fmt.Errorf("failed to create books: %w", err)
-			}
-			booksList = append(booksList, books)
+			err = add(kind, kind.String(), func(client *http.Client) (Books, error) {
+				return googlebooks.NewGoogleBooks(lg, config.Google, client)
+			})
 		case booksconfig.NDL:
-			books, err := ndlbooks.NewNDL()
-			if err != nil {
-				return nil, fmt.Errorf("failed to create books: %w", err)
+			err = add(kind, kind.String(), func(client *http.Client) (Books, error) {
+				return ndlbooks.NewNDL(config.NDL, client)
+			})
+		case booksconfig.OpenLibrary:
+			err = add(kind, kind.String(), func(client *http.Client) (Books, error) {
+				return openlibrarybooks.NewOpenLibrary(config.OpenLibrary, client)
+			})
+		case booksconfig.OpenBD:
+			err = add(kind, kind.String(), func(client *http.Client) (Books, error) {
+				return openbdbooks.NewOpenBD(config.OpenBD, client)
+			})
+		case booksconfig.HTTP:
+			for _, c := range config.HTTP {
+				if err = add(kind, kind.String()+"/"+c.Name, func(client *http.Client) (Books, error) {
+					return httpbooks.NewHTTPBooks(c, client)
+				}); err != nil {
+					break
+				}
 			}
-			booksList = append(booksList, books)
 		default:
 			return nil, fmt.Errorf("failed to create books: Unknown Compoent")
 		}
+		if err != nil {
+			return nil, err
+		}
 	}
 	return booksList, nil
 }
@@ -0,0 +1,241 @@
+package bookscache
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+	booksfixture "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/fixture"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+)
+
+// schemaVersion は Response に保存する記録の形式の版
+// 1, 2 は解析済みの bookscommon.Info を保存していた
+// 3 からはプロバイダーの生のレスポンスを保存し、読むたびに今のパーサーで解析し直す
+const schemaVersion = 3
+
+const (
+	defaultTTL         = 30 * 24 * time.Hour
+	defaultNegativeTTL = 24 * time.Hour
+)
+
+type Books interface {
+	Close() error
+	GetInfo(isbn string) (*bookscommon.Info, error)
+}
+
+// Store はキャッシュの保存先
+// GetCache はエントリが無い場合 storecommon.ErrNotFoundCache を返す
+type Store interface {
+	GetCache(source, isbn string) (bookscommon.CacheEntry, error)
+	PutCache(entry bookscommon.CacheEntry) error
+}
+
+// NewBooks は HTTP の Transport を wrap で包んだ Books を作る
+type NewBooks func(wrap func(http.RoundTripper) http.RoundTripper) (Books, error)
+
+// CachedBooks は Books が受け取った HTTP のレスポンスを ISBN ごとに Store にキャッシュする
+// キャッシュがあればそのレスポンスを返す Books を newBooks で作って解析し直す
+type CachedBooks struct {
+	lg *slog.Logger
+
+	books    Books
+	newBooks NewBooks
+	source   string
+	store    Store
+
+	ttl         time.Duration
+	negativeTTL time.Duration
+}
+
+func NewCachedBooks(lg *slog.Logger, config booksconfig.CacheConfig, source string, books Books, newBooks NewBooks, store Store) *CachedBooks {
+	ttl := config.TTL
+	if ttl == 0 {
+		ttl = defaultTTL
+	}
+	negativeTTL := config.NegativeTTL
+	if negativeTTL == 0 {
+		negativeTTL = defaultNegativeTTL
+	}
+	return &CachedBooks{
+		lg:          lg.With(slog.String("Package", "cache"), slog.String("source", source)),
+		books:       books,
+		newBooks:    newBooks,
+		source:      source,
+		store:       store,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+	}
+}
+
+func (s *CachedBooks) Close() error {
+	return s.books.Close()
+}
+
+// Search は検索結果をキャッシュせずにそのまま返す
+func (s *CachedBooks) Search(query bookscommon.Query) ([]bookscommon.Info, error) {
+	searcher, ok := s.books.(interface {
+		Search(query bookscommon.Query) ([]bookscommon.Info, error)
+	})
+	if !ok {
+		return nil, bookscommon.ErrNotSupported
+	}
+	return searcher.Search(query)
+}
+
+// GetInfoByIdentifier はキャッシュせずにそのまま返す
+func (s *CachedBooks) GetInfoByIdentifier(id bookscommon.Identifier) (*bookscommon.Info, error) {
+	lookup, ok := s.books.(interface {
+		GetInfoByIdentifier(id bookscommon.Identifier) (*bookscommon.Info, error)
+	})
+	if !ok {
+		return nil, bookscommon.ErrNotSupported
+	}
+	return lookup.GetInfoByIdentifier(id)
+}
+
+func (s *CachedBooks) GetInfo(isbn string) (*bookscommon.Info, error) {
+	rec := &recorder{cached: make(map[string]booksfixture.Fixture)}
+	var expiresAt time.Time
+	entry, err := s.store.GetCache(s.source, isbn)
+	switch {
+	case err == nil && entry.Schema != schemaVersion:
+		s.lg.Info("provider cache schema mismatch", slog.String("isbn", isbn), slog.Int("schema", entry.Schema))
+	case err == nil && time.Now().Before(entry.ExpiresAt):
+		if entry.NotFound {
+			s.lg.Info("provider cache hit", slog.String("isbn", isbn), slog.Bool("not_found", true))
+			return nil, fmt.Errorf("%w: %s", bookscommon.ErrNotFoundBook, isbn)
+		}
+		var fixtures []booksfixture.Fixture
+		if err := json.Unmarshal(entry.Response, &fixtures); err != nil {
+			s.lg.Warn("failed to decode cached response", slog.String("isbn", isbn), slog.String("err", err.Error()))
+			break
+		}
+		s.lg.Info("provider cache hit", slog.String("isbn", isbn), slog.Bool("not_found", false))
+		for _, f := range fixtures {
+			rec.cached[f.Method+" "+f.URL] = f
+		}
+		expiresAt = entry.ExpiresAt
+	case err == nil:
+		s.lg.Info("provider cache expired", slog.String("isbn", isbn))
+	case errors.Is(err, storecommon.ErrNotFoundCache):
+		s.lg.Info("provider cache miss", slog.String("isbn", isbn))
+	default:
+		s.lg.Warn("failed to get cache", slog.String("isbn", isbn), slog.String("err", err.Error()))
+	}
+
+	books, err := s.newBooks(func(next http.RoundTripper) http.RoundTripper {
+		rec.next = next
+		return rec
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create books: %w", err)
+	}
+	defer books.Close()
+
+	info, err := books.GetInfo(isbn)
+	now := time.Now().UTC()
+	entry = bookscommon.CacheEntry{
+		Source:    s.source,
+		ISBN:      isbn,
+		Schema:    schemaVersion,
+		CreatedAt: now,
+	}
+	switch {
+	case errors.Is(err, bookscommon.ErrNotFoundBook):
+		entry.NotFound = true
+		entry.ExpiresAt = now.Add(s.negativeTTL)
+	case err != nil:
+		// 一時的なエラーはキャッシュしない
+		return nil, err
+	case !expiresAt.IsZero() && !rec.fetched:
+		// 全てキャッシュから返せた
+		return info, nil
+	default:
+		entry.Response, err = json.Marshal(rec.fixtures)
+		if err != nil {
+			s.lg.Warn("failed to encode response", slog.String("isbn", isbn), slog.String("err", err.Error()))
+			return info, nil
+		}
+		// キャッシュに無いリクエストが増えた場合は期限を延ばさずに書き足す
+		entry.ExpiresAt = expiresAt
+		if expiresAt.IsZero() {
+			entry.ExpiresAt = now.Add(s.ttl)
+		}
+	}
+
+	if err := s.store.PutCache(entry); err != nil {
+		s.lg.Warn("failed to put cache", slog.String("isbn", isbn), slog.String("err", err.Error()))
+	}
+	if entry.NotFound {
+		return nil, fmt.Errorf("%w: %s", bookscommon.ErrNotFoundBook, isbn)
+	}
+	return info, nil
+}
+
+// recorder は一回の GetInfo のリクエストに cached から応え、無いものは next に送って記録する
+// API キーを除いた URL で引くので、キーを変えてもキャッシュは使える
+type recorder struct {
+	next   http.RoundTripper
+	cached map[string]booksfixture.Fixture
+
+	mu       sync.Mutex
+	fixtures []booksfixture.Fixture
+	fetched  bool
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := booksfixture.Key(req.URL)
+	if f, ok := r.cached[req.Method+" "+key]; ok {
+		r.add(f, false)
+		return f.Response(req), nil
+	}
+
+	next := r.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	// サーバーのエラーは記録せず、次も取りに行く
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %w", err)
+	}
+	f := booksfixture.Fixture{
+		Method: req.Method,
+		URL:    key,
+		Status: resp.StatusCode,
+		Header: http.Header{},
+		Body:   string(body),
+	}
+	for _, h := range []string{"Content-Type", "Location"} {
+		if v := resp.Header.Get(h); v != "" {
+			f.Header.Set(h, v)
+		}
+	}
+	r.add(f, true)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+func (r *recorder) add(f booksfixture.Fixture, fetched bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fixtures = append(r.fixtures, f)
+	r.fetched = r.fetched || fetched
+}
@@ -0,0 +1,232 @@
+package bookscache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+	booksfixture "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/fixture"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+)
+
+const testURL = "https://catalog.example.com/books/9784101001565"
+
+// fakeTransport は本文が title の書誌を返し、リクエストの回数を数える
+type fakeTransport struct {
+	title string
+	calls int
+}
+
+func (t *fakeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	if t.title == "" {
+		return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(t.title)), Request: req}, nil
+}
+
+// fakeBooks は一回の GetInfo で一つか二つのリクエストをする
+type fakeBooks struct {
+	client *http.Client
+	// withPublisher の場合は出版社も別のリクエストで取る (パーサーが変わった場合を真似る)
+	withPublisher bool
+}
+
+func (b *fakeBooks) Close() error { return nil }
+
+func (b *fakeBooks) get(u string) (string, int, error) {
+	resp, err := b.client.Get(u + "?key=secret")
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	return string(body), resp.StatusCode, err
+}
+
+func (b *fakeBooks) GetInfo(isbn string) (*bookscommon.Info, error) {
+	title, status, err := b.get(testURL)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", bookscommon.ErrNotFoundBook, isbn)
+	}
+	info := &bookscommon.Info{ISBN: isbn, Title: title}
+	if b.withPublisher {
+		if info.Publisher, _, err = b.get(testURL + "/publisher"); err != nil {
+			return nil, err
+		}
+	}
+	return info, nil
+}
+
+type fakeStore struct {
+	entries map[string]bookscommon.CacheEntry
+}
+
+func (s *fakeStore) GetCache(source, isbn string) (bookscommon.CacheEntry, error) {
+	entry, ok := s.entries[source+"/"+isbn]
+	if !ok {
+		return bookscommon.CacheEntry{}, storecommon.ErrNotFoundCache
+	}
+	return entry, nil
+}
+
+func (s *fakeStore) PutCache(entry bookscommon.CacheEntry) error {
+	s.entries[entry.Source+"/"+entry.ISBN] = entry
+	return nil
+}
+
+func newTestCachedBooks(transport *fakeTransport, store *fakeStore, withPublisher bool) *CachedBooks {
+	newBooks := func(wrap func(http.RoundTripper) http.RoundTripper) (Books, error) {
+		return &fakeBooks{client: &http.Client{Transport: wrap(transport)}, withPublisher: withPublisher}, nil
+	}
+	books, _ := newBooks(func(next http.RoundTripper) http.RoundTripper { return next })
+	return NewCachedBooks(slog.New(slog.NewTextHandler(io.Discard, nil)), booksconfig.CacheConfig{}, "test", books, newBooks, store)
+}
+
+func cachedResponse(t *testing.T, fixtures ...booksfixture.Fixture) []byte {
+	t.Helper()
+	b, err := json.Marshal(fixtures)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	return b
+}
+
+func TestGetInfo(t *testing.T) {
+	const isbn = "9784101001565"
+	future := time.Now().Add(time.Hour)
+	cached := booksfixture.Fixture{Method: http.MethodGet, URL: testURL, Status: http.StatusOK, Body: "cached"}
+	tests := []struct {
+		name      string
+		entry     *bookscommon.CacheEntry
+		wantTitle string
+		wantCalls int
+	}{
+		{
+			name:      "miss",
+			wantTitle: "fetched",
+			wantCalls: 1,
+		},
+		{
+			name:      "hit",
+			entry:     &bookscommon.CacheEntry{Response: cachedResponse(t, cached), Schema: schemaVersion, ExpiresAt: future},
+			wantTitle: "cached",
+			wantCalls: 0,
+		},
+		{
+			name:      "expired",
+			entry:     &bookscommon.CacheEntry{Response: cachedResponse(t, cached), Schema: schemaVersion, ExpiresAt: time.Now().Add(-time.Hour)},
+			wantTitle: "fetched",
+			wantCalls: 1,
+		},
+		{
+			name:      "old schema",
+			entry:     &bookscommon.CacheEntry{Response: []byte(`{"Title":"cached"}`), Schema: 2, ExpiresAt: future},
+			wantTitle: "fetched",
+			wantCalls: 1,
+		},
+		{
+			name:      "undecodable",
+			entry:     &bookscommon.CacheEntry{Response: []byte(`[{"method":`), Schema: schemaVersion, ExpiresAt: future},
+			wantTitle: "fetched",
+			wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &fakeStore{entries: map[string]bookscommon.CacheEntry{}}
+			if tt.entry != nil {
+				entry := *tt.entry
+				entry.Source, entry.ISBN = "test", isbn
+				store.entries["test/"+isbn] = entry
+			}
+			transport := &fakeTransport{title: "fetched"}
+			info, err := newTestCachedBooks(transport, store, false).GetInfo(isbn)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+			if info.Title != tt.wantTitle {
+				t.Errorf("Title = %q, want %q", info.Title, tt.wantTitle)
+			}
+			if transport.calls != tt.wantCalls {
+				t.Errorf("provider requests = %d, want %d", transport.calls, tt.wantCalls)
+			}
+
+			entry := store.entries["test/"+isbn]
+			if entry.Schema != schemaVersion {
+				t.Errorf("stored schema = %d, want %d", entry.Schema, schemaVersion)
+			}
+			// 生のレスポンスを API キーを除いた URL で保存する
+			var fixtures []booksfixture.Fixture
+			if err := json.Unmarshal(entry.Response, &fixtures); err != nil {
+				t.Fatalf("stored response error = %v", err)
+			}
+			if len(fixtures) != 1 || fixtures[0].URL != testURL || fixtures[0].Body != tt.wantTitle {
+				t.Errorf("stored response = %+v, want body %q from %s", fixtures, tt.wantTitle, testURL)
+			}
+		})
+	}
+}
+
+// パーサーが新しいリクエストをするようになっても、キャッシュにあるものは使って足りない分だけ取る
+func TestGetInfoPartialHit(t *testing.T) {
+	const isbn = "9784101001565"
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	store := &fakeStore{entries: map[string]bookscommon.CacheEntry{
+		"test/" + isbn: {
+			Source:    "test",
+			ISBN:      isbn,
+			Response:  cachedResponse(t, booksfixture.Fixture{Method: http.MethodGet, URL: testURL, Status: http.StatusOK, Body: "cached"}),
+			Schema:    schemaVersion,
+			ExpiresAt: expiresAt,
+		},
+	}}
+	transport := &fakeTransport{title: "新潮社"}
+	books := newTestCachedBooks(transport, store, true)
+
+	for range 2 {
+		info, err := books.GetInfo(isbn)
+		if err != nil {
+			t.Fatalf("GetInfo() error = %v", err)
+		}
+		if info.Title != "cached" || info.Publisher != "新潮社" {
+			t.Errorf("GetInfo() = %q / %q, want %q / %q", info.Title, info.Publisher, "cached", "新潮社")
+		}
+	}
+	if transport.calls != 1 {
+		t.Errorf("provider requests = %d, want 1", transport.calls)
+	}
+	if got := store.entries["test/"+isbn].ExpiresAt; !got.Equal(expiresAt) {
+		t.Errorf("ExpiresAt = %v, want %v", got, expiresAt)
+	}
+}
+
+func TestGetInfoNotFound(t *testing.T) {
+	const isbn = "9780000000002"
+	store := &fakeStore{entries: map[string]bookscommon.CacheEntry{}}
+	transport := &fakeTransport{}
+	books := newTestCachedBooks(transport, store, false)
+
+	for range 2 {
+		if _, err := books.GetInfo(isbn); !errors.Is(err, bookscommon.ErrNotFoundBook) {
+			t.Errorf("GetInfo() error = %v, want %v", err, bookscommon.ErrNotFoundBook)
+		}
+	}
+	if transport.calls != 1 {
+		t.Errorf("provider requests = %d, want 1", transport.calls)
+	}
+	if !store.entries["test/"+isbn].NotFound {
+		t.Error("NotFound is not cached")
+	}
+}
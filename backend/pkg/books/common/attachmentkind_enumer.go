@@ -0,0 +1,90 @@
+// Code generated by "enumer -type=AttachmentKind -trimprefix=Attachment"; DO NOT EDIT.
+
+package bookscommon
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _AttachmentKindName = "OtherBackCoverSpineInteriorDamage"
+
+var _AttachmentKindIndex = [...]uint8{0, 5, 14, 19, 27, 33}
+
+const _AttachmentKindLowerName = "otherbackcoverspineinteriordamage"
+
+func (i AttachmentKind) String() string {
+	if i < 0 || i >= AttachmentKind(len(_AttachmentKindIndex)-1) {
+		return fmt.Sprintf("AttachmentKind(%d)", i)
+	}
+	return _AttachmentKindName[_AttachmentKindIndex[i]:_AttachmentKindIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _AttachmentKindNoOp() {
+	var x [1]struct{}
+	_ = x[AttachmentOther-(0)]
+	_ = x[AttachmentBackCover-(1)]
+	_ = x[AttachmentSpine-(2)]
+	_ = x[AttachmentInterior-(3)]
+	_ = x[AttachmentDamage-(4)]
+}
+
+var _AttachmentKindValues = []AttachmentKind{AttachmentOther, AttachmentBackCover, AttachmentSpine, AttachmentInterior, AttachmentDamage}
+
+var _AttachmentKindNameToValueMap = map[string]AttachmentKind{
+	_AttachmentKindName[0:5]:        AttachmentOther,
+	_AttachmentKindLowerName[0:5]:   AttachmentOther,
+	_AttachmentKindName[5:14]:       AttachmentBackCover,
+	_AttachmentKindLowerName[5:14]:  AttachmentBackCover,
+	_AttachmentKindName[14:19]:      AttachmentSpine,
+	_AttachmentKindLowerName[14:19]: AttachmentSpine,
+	_AttachmentKindName[19:27]:      AttachmentInterior,
+	_AttachmentKindLowerName[19:27]: AttachmentInterior,
+	_AttachmentKindName[27:33]:      AttachmentDamage,
+	_AttachmentKindLowerName[27:33]: AttachmentDamage,
+}
+
+var _AttachmentKindNames = []string{
+	_AttachmentKindName[0:5],
+	_AttachmentKindName[5:14],
+	_AttachmentKindName[14:19],
+	_AttachmentKindName[19:27],
+	_AttachmentKindName[27:33],
+}
+
+// AttachmentKindString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func AttachmentKindString(s string) (AttachmentKind, error) {
+	if val, ok := _AttachmentKindNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _AttachmentKindNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to AttachmentKind values", s)
+}
+
+// AttachmentKindValues returns all values of the enum
+func AttachmentKindValues() []AttachmentKind {
+	return _AttachmentKindValues
+}
+
+// AttachmentKindStrings returns a slice of all String values of the enum
+func AttachmentKindStrings() []string {
+	strs := make([]string, len(_AttachmentKindNames))
+	copy(strs, _AttachmentKindNames)
+	return strs
+}
+
+// IsAAttachmentKind returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i AttachmentKind) IsAAttachmentKind() bool {
+	for _, v := range _AttachmentKindValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
@@ -1,3 +1,8 @@
 package bookscommon
 
+import "fmt"
+
 const NoDescription = "No description"
+
+var ErrNotFoundBook = fmt.Errorf("not found book")
+var ErrNotSupported = fmt.Errorf("not supported")
@@ -0,0 +1,101 @@
+package bookscommon
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// 目録の責任表示で名前の後ろに付く役割
+var roleSuffixes = map[string]ContributorRole{
+	"著":    Author,
+	"著者":   Author,
+	"作":    Author,
+	"文":    Author,
+	"作・文":  Author,
+	"編著":   Author,
+	"共著":   Author,
+	"訳":    Translator,
+	"翻訳":   Translator,
+	"共訳":   Translator,
+	"監訳":   Translator,
+	"絵":    Illustrator,
+	"画":    Illustrator,
+	"作画":   Illustrator,
+	"漫画":   Illustrator,
+	"イラスト": Illustrator,
+	"挿絵":   Illustrator,
+	"編":    Editor,
+	"編集":   Editor,
+	"編者":   Editor,
+	"監修":   Editor,
+	"責任編集": Editor,
+	"原作":   OriginalCreator,
+	"原著":   OriginalCreator,
+	"原案":   OriginalCreator,
+}
+
+// Authors は役割の分からない名前を全て著者として扱う
+func Authors(names []string) []Contributor {
+	var contributors []Contributor
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			contributors = append(contributors, Contributor{Name: name, Role: Author})
+		}
+	}
+	return contributors
+}
+
+// ParseContributors は "John Smith 著 ; 鈴木一郎 訳" のような責任表示を分解する
+// openBD の "夏目漱石／著" のように "／" で区切った役割も読む
+// 役割が書かれていない名前は著者とする
+func ParseContributors(s string) []Contributor {
+	var contributors []Contributor
+	for _, part := range strings.Split(s, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, role := part, Author
+		// "山田太郎 著" と "山田太郎[著]" のどちらの形もある
+		i := strings.LastIndexAny(part, " 　／/")
+		if strings.HasSuffix(part, "]") || strings.HasSuffix(part, "］") {
+			i = max(i, strings.LastIndexAny(part, "[［"))
+		}
+		if i > 0 {
+			suffix := strings.Trim(part[i:], " 　／/[]［］")
+			if r, ok := roleSuffixes[suffix]; ok {
+				name, role = strings.TrimSpace(part[:i]), r
+			}
+		}
+		contributors = append(contributors, Contributor{Name: name, Role: role})
+	}
+	return contributors
+}
+
+// AuthorNames は表示用に著者の名前を返す (著者がいない場合は全員)
+func (info Info) AuthorNames() []string {
+	var names, all []string
+	for _, c := range info.Contributors {
+		all = append(all, c.Name)
+		if c.Role == Author {
+			names = append(names, c.Name)
+		}
+	}
+	if len(names) == 0 {
+		return all
+	}
+	return names
+}
+
+// NormalizeName は表記ゆれを比べるために名前の全角・半角、大文字・小文字を揃えて空白を取り除く
+func NormalizeName(name string) string {
+	name = strings.ToLower(norm.NFKC.String(name))
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, name)
+}
@@ -0,0 +1,90 @@
+// Code generated by "enumer -type=ContributorRole"; DO NOT EDIT.
+
+package bookscommon
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _ContributorRoleName = "AuthorTranslatorIllustratorEditorOriginalCreator"
+
+var _ContributorRoleIndex = [...]uint8{0, 6, 16, 27, 33, 48}
+
+const _ContributorRoleLowerName = "authortranslatorillustratoreditororiginalcreator"
+
+func (i ContributorRole) String() string {
+	if i < 0 || i >= ContributorRole(len(_ContributorRoleIndex)-1) {
+		return fmt.Sprintf("ContributorRole(%d)", i)
+	}
+	return _ContributorRoleName[_ContributorRoleIndex[i]:_ContributorRoleIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _ContributorRoleNoOp() {
+	var x [1]struct{}
+	_ = x[Author-(0)]
+	_ = x[Translator-(1)]
+	_ = x[Illustrator-(2)]
+	_ = x[Editor-(3)]
+	_ = x[OriginalCreator-(4)]
+}
+
+var _ContributorRoleValues = []ContributorRole{Author, Translator, Illustrator, Editor, OriginalCreator}
+
+var _ContributorRoleNameToValueMap = map[string]ContributorRole{
+	_ContributorRoleName[0:6]:        Author,
+	_ContributorRoleLowerName[0:6]:   Author,
+	_ContributorRoleName[6:16]:       Translator,
+	_ContributorRoleLowerName[6:16]:  Translator,
+	_ContributorRoleName[16:27]:      Illustrator,
+	_ContributorRoleLowerName[16:27]: Illustrator,
+	_ContributorRoleName[27:33]:      Editor,
+	_ContributorRoleLowerName[27:33]: Editor,
+	_ContributorRoleName[33:48]:      OriginalCreator,
+	_ContributorRoleLowerName[33:48]: OriginalCreator,
+}
+
+var _ContributorRoleNames = []string{
+	_ContributorRoleName[0:6],
+	_ContributorRoleName[6:16],
+	_ContributorRoleName[16:27],
+	_ContributorRoleName[27:33],
+	_ContributorRoleName[33:48],
+}
+
+// ContributorRoleString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ContributorRoleString(s string) (ContributorRole, error) {
+	if val, ok := _ContributorRoleNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _ContributorRoleNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to ContributorRole values", s)
+}
+
+// ContributorRoleValues returns all values of the enum
+func ContributorRoleValues() []ContributorRole {
+	return _ContributorRoleValues
+}
+
+// ContributorRoleStrings returns a slice of all String values of the enum
+func ContributorRoleStrings() []string {
+	strs := make([]string, len(_ContributorRoleNames))
+	copy(strs, _ContributorRoleNames)
+	return strs
+}
+
+// IsAContributorRole returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i ContributorRole) IsAContributorRole() bool {
+	for _, v := range _ContributorRoleValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
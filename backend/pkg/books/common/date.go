@@ -0,0 +1,104 @@
+package bookscommon
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+//go:generate go run github.com/dmarkham/enumer -type=DatePrecision -trimprefix=Date
+type DatePrecision int32
+
+const (
+	DateUnknown DatePrecision = iota
+	DateYear
+	DateMonth
+	DateDay
+)
+
+// DefaultDateLayouts は日付の解析に使う time.Parse のレイアウト
+var DefaultDateLayouts = []string{"2006-01-02", "2006-01", "2006"}
+
+// Date は分かっている精度 (年・年月・年月日) 付きの日付
+// Time は精度より細かい部分を切り捨てた期間の初日で、ゼロ値は日付が分からないことを表す
+type Date struct {
+	Time      time.Time
+	Precision DatePrecision
+}
+
+// NewDate は t を precision より細かい部分を切り捨てた Date にする
+func NewDate(t time.Time, precision DatePrecision) Date {
+	if t.IsZero() || precision == DateUnknown {
+		return Date{}
+	}
+	month, day := t.Month(), t.Day()
+	switch precision {
+	case DateYear:
+		month, day = time.January, 1
+	case DateMonth:
+		day = 1
+	}
+	return Date{
+		Time:      time.Date(t.Year(), month, day, 0, 0, 0, 0, time.UTC),
+		Precision: precision,
+	}
+}
+
+// ParseDate は layouts (空の場合は DefaultDateLayouts) を順に試して日付を解析する
+// 精度は成功したレイアウトに日や月が含まれるかで決める
+func ParseDate(s string, layouts ...string) (Date, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Date{}, fmt.Errorf("date is empty")
+	}
+	if len(layouts) == 0 {
+		layouts = DefaultDateLayouts
+	}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return NewDate(t, LayoutPrecision(layout)), nil
+		}
+	}
+	return Date{}, fmt.Errorf("failed to convert date: %s", s)
+}
+
+// LayoutPrecision は time.Parse のレイアウトが表す日付の精度を返す
+func LayoutPrecision(layout string) DatePrecision {
+	// 年の "2006" を除けば日は "2" か "02"、月は "1" か "01" か "Jan" を含む
+	layout = strings.ReplaceAll(layout, "2006", "")
+	switch {
+	case strings.Contains(layout, "2"):
+		return DateDay
+	case strings.Contains(layout, "1"), strings.Contains(layout, "Jan"):
+		return DateMonth
+	default:
+		return DateYear
+	}
+}
+
+func (d Date) IsZero() bool {
+	return d.Precision == DateUnknown || d.Time.IsZero()
+}
+
+// String は分かっている精度で "2006", "2006-01", "2006-01-02" の形にする
+func (d Date) String() string {
+	switch {
+	case d.IsZero():
+		return ""
+	case d.Precision == DateYear:
+		return d.Time.Format("2006")
+	case d.Precision == DateMonth:
+		return d.Time.Format("2006-01")
+	default:
+		return d.Time.Format("2006-01-02")
+	}
+}
+
+// After は d が o より新しいかを返す
+// 期間の初日が同じ場合は精度が高い方を新しいとする ("2006-01-01" は "2006-01" より後)
+func (d Date) After(o Date) bool {
+	if !d.Time.Equal(o.Time) {
+		return d.Time.After(o.Time)
+	}
+	return d.Precision > o.Precision
+}
@@ -0,0 +1,86 @@
+// Code generated by "enumer -type=DatePrecision -trimprefix=Date"; DO NOT EDIT.
+
+package bookscommon
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _DatePrecisionName = "UnknownYearMonthDay"
+
+var _DatePrecisionIndex = [...]uint8{0, 7, 11, 16, 19}
+
+const _DatePrecisionLowerName = "unknownyearmonthday"
+
+func (i DatePrecision) String() string {
+	if i < 0 || i >= DatePrecision(len(_DatePrecisionIndex)-1) {
+		return fmt.Sprintf("DatePrecision(%d)", i)
+	}
+	return _DatePrecisionName[_DatePrecisionIndex[i]:_DatePrecisionIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _DatePrecisionNoOp() {
+	var x [1]struct{}
+	_ = x[DateUnknown-(0)]
+	_ = x[DateYear-(1)]
+	_ = x[DateMonth-(2)]
+	_ = x[DateDay-(3)]
+}
+
+var _DatePrecisionValues = []DatePrecision{DateUnknown, DateYear, DateMonth, DateDay}
+
+var _DatePrecisionNameToValueMap = map[string]DatePrecision{
+	_DatePrecisionName[0:7]:        DateUnknown,
+	_DatePrecisionLowerName[0:7]:   DateUnknown,
+	_DatePrecisionName[7:11]:       DateYear,
+	_DatePrecisionLowerName[7:11]:  DateYear,
+	_DatePrecisionName[11:16]:      DateMonth,
+	_DatePrecisionLowerName[11:16]: DateMonth,
+	_DatePrecisionName[16:19]:      DateDay,
+	_DatePrecisionLowerName[16:19]: DateDay,
+}
+
+var _DatePrecisionNames = []string{
+	_DatePrecisionName[0:7],
+	_DatePrecisionName[7:11],
+	_DatePrecisionName[11:16],
+	_DatePrecisionName[16:19],
+}
+
+// DatePrecisionString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func DatePrecisionString(s string) (DatePrecision, error) {
+	if val, ok := _DatePrecisionNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _DatePrecisionNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to DatePrecision values", s)
+}
+
+// DatePrecisionValues returns all values of the enum
+func DatePrecisionValues() []DatePrecision {
+	return _DatePrecisionValues
+}
+
+// DatePrecisionStrings returns a slice of all String values of the enum
+func DatePrecisionStrings() []string {
+	strs := make([]string, len(_DatePrecisionNames))
+	copy(strs, _DatePrecisionNames)
+	return strs
+}
+
+// IsADatePrecision returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i DatePrecision) IsADatePrecision() bool {
+	for _, v := range _DatePrecisionValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
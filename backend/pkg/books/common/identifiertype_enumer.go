@@ -0,0 +1,94 @@
+// Code generated by "enumer -type=IdentifierType"; DO NOT EDIT.
+
+package bookscommon
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _IdentifierTypeName = "ISBNJPNONCIDASINISSNOCLC"
+
+var _IdentifierTypeIndex = [...]uint8{0, 4, 8, 12, 16, 20, 24}
+
+const _IdentifierTypeLowerName = "isbnjpnoncidasinissnoclc"
+
+func (i IdentifierType) String() string {
+	if i < 0 || i >= IdentifierType(len(_IdentifierTypeIndex)-1) {
+		return fmt.Sprintf("IdentifierType(%d)", i)
+	}
+	return _IdentifierTypeName[_IdentifierTypeIndex[i]:_IdentifierTypeIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _IdentifierTypeNoOp() {
+	var x [1]struct{}
+	_ = x[ISBN-(0)]
+	_ = x[JPNO-(1)]
+	_ = x[NCID-(2)]
+	_ = x[ASIN-(3)]
+	_ = x[ISSN-(4)]
+	_ = x[OCLC-(5)]
+}
+
+var _IdentifierTypeValues = []IdentifierType{ISBN, JPNO, NCID, ASIN, ISSN, OCLC}
+
+var _IdentifierTypeNameToValueMap = map[string]IdentifierType{
+	_IdentifierTypeName[0:4]:        ISBN,
+	_IdentifierTypeLowerName[0:4]:   ISBN,
+	_IdentifierTypeName[4:8]:        JPNO,
+	_IdentifierTypeLowerName[4:8]:   JPNO,
+	_IdentifierTypeName[8:12]:       NCID,
+	_IdentifierTypeLowerName[8:12]:  NCID,
+	_IdentifierTypeName[12:16]:      ASIN,
+	_IdentifierTypeLowerName[12:16]: ASIN,
+	_IdentifierTypeName[16:20]:      ISSN,
+	_IdentifierTypeLowerName[16:20]: ISSN,
+	_IdentifierTypeName[20:24]:      OCLC,
+	_IdentifierTypeLowerName[20:24]: OCLC,
+}
+
+var _IdentifierTypeNames = []string{
+	_IdentifierTypeName[0:4],
+	_IdentifierTypeName[4:8],
+	_IdentifierTypeName[8:12],
+	_IdentifierTypeName[12:16],
+	_IdentifierTypeName[16:20],
+	_IdentifierTypeName[20:24],
+}
+
+// IdentifierTypeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func IdentifierTypeString(s string) (IdentifierType, error) {
+	if val, ok := _IdentifierTypeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _IdentifierTypeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to IdentifierType values", s)
+}
+
+// IdentifierTypeValues returns all values of the enum
+func IdentifierTypeValues() []IdentifierType {
+	return _IdentifierTypeValues
+}
+
+// IdentifierTypeStrings returns a slice of all String values of the enum
+func IdentifierTypeStrings() []string {
+	strs := make([]string, len(_IdentifierTypeNames))
+	copy(strs, _IdentifierTypeNames)
+	return strs
+}
+
+// IsAIdentifierType returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i IdentifierType) IsAIdentifierType() bool {
+	for _, v := range _IdentifierTypeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+// Code generated by "enumer -type=ImageOrigin -trimprefix=Image"; DO NOT EDIT.
+
+package bookscommon
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _ImageOriginName = "ProviderUserGeneratedSelected"
+
+var _ImageOriginIndex = [...]uint8{0, 8, 12, 21, 29}
+
+const _ImageOriginLowerName = "providerusergeneratedselected"
+
+func (i ImageOrigin) String() string {
+	if i < 0 || i >= ImageOrigin(len(_ImageOriginIndex)-1) {
+		return fmt.Sprintf("ImageOrigin(%d)", i)
+	}
+	return _ImageOriginName[_ImageOriginIndex[i]:_ImageOriginIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _ImageOriginNoOp() {
+	var x [1]struct{}
+	_ = x[ImageProvider-(0)]
+	_ = x[ImageUser-(1)]
+	_ = x[ImageGenerated-(2)]
+	_ = x[ImageSelected-(3)]
+}
+
+var _ImageOriginValues = []ImageOrigin{ImageProvider, ImageUser, ImageGenerated, ImageSelected}
+
+var _ImageOriginNameToValueMap = map[string]ImageOrigin{
+	_ImageOriginName[0:8]:        ImageProvider,
+	_ImageOriginLowerName[0:8]:   ImageProvider,
+	_ImageOriginName[8:12]:       ImageUser,
+	_ImageOriginLowerName[8:12]:  ImageUser,
+	_ImageOriginName[12:21]:      ImageGenerated,
+	_ImageOriginLowerName[12:21]: ImageGenerated,
+	_ImageOriginName[21:29]:      ImageSelected,
+	_ImageOriginLowerName[21:29]: ImageSelected,
+}
+
+var _ImageOriginNames = []string{
+	_ImageOriginName[0:8],
+	_ImageOriginName[8:12],
+	_ImageOriginName[12:21],
+	_ImageOriginName[21:29],
+}
+
+// ImageOriginString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ImageOriginString(s string) (ImageOrigin, error) {
+	if val, ok := _ImageOriginNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _ImageOriginNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to ImageOrigin values", s)
+}
+
+// ImageOriginValues returns all values of the enum
+func ImageOriginValues() []ImageOrigin {
+	return _ImageOriginValues
+}
+
+// ImageOriginStrings returns a slice of all String values of the enum
+func ImageOriginStrings() []string {
+	strs := make([]string, len(_ImageOriginNames))
+	copy(strs, _ImageOriginNames)
+	return strs
+}
+
+// IsAImageOrigin returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i ImageOrigin) IsAImageOrigin() bool {
+	for _, v := range _ImageOriginValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
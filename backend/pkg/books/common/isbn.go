@@ -0,0 +1,68 @@
+package bookscommon
+
+import (
+	"strconv"
+	"strings"
+)
+
+// NormalizeISBN はハイフンや空白を取り除き、ISBN-10 を ISBN-13 に変換する
+// ISBN として正しくない場合は空文字を返す
+func NormalizeISBN(s string) string {
+	s = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(s))
+	switch len(s) {
+	case 13:
+		for _, r := range s {
+			if r < '0' || r > '9' {
+				return ""
+			}
+		}
+		if s[12] != isbn13CheckDigit(s[:12]) {
+			return ""
+		}
+		return s
+	case 10:
+		sum := 0
+		for i, r := range s {
+			d := int(r - '0')
+			if i == 9 && r == 'X' {
+				d = 10
+			} else if r < '0' || r > '9' {
+				return ""
+			}
+			sum += d * (10 - i)
+		}
+		if sum%11 != 0 {
+			return ""
+		}
+		body := "978" + s[:9]
+		return body + string(isbn13CheckDigit(body))
+	default:
+		return ""
+	}
+}
+
+func isbn13CheckDigit(body string) byte {
+	sum := 0
+	for i, r := range body {
+		d := int(r - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return strconv.Itoa((10 - sum%10) % 10)[0]
+}
+
+// NormalizeIdentifier は種類ごとに表記を揃える
+func NormalizeIdentifier(id Identifier) Identifier {
+	value := strings.TrimSpace(id.Value)
+	switch id.Type {
+	case ISBN:
+		value = NormalizeISBN(value)
+	case ISSN:
+		value = strings.ToUpper(strings.ReplaceAll(value, "-", ""))
+	case ASIN, NCID:
+		value = strings.ToUpper(value)
+	}
+	return Identifier{Type: id.Type, Value: value}
+}
@@ -0,0 +1,92 @@
+package bookscommon
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/language"
+)
+
+// Language は ISO 639 の言語コード
+// ISO 639-1 の 2 文字コードがある言語はそれを、無い言語は ISO 639-3 の 3 文字コードを使う
+type Language string
+
+const (
+	Japanese Language = "ja"
+	English  Language = "en"
+	Chinese  Language = "zh"
+	Korean   Language = "ko"
+)
+
+// ParseLanguage は ISO 639-1/639-2 (B/T)/639-3 のコードや "en-US" のような BCP 47 の言語タグを Language に変換する
+// 分からない場合は空を返す
+func ParseLanguage(s string) Language {
+	tag, err := language.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return ""
+	}
+	base, confidence := tag.Base()
+	if confidence != language.Exact {
+		return ""
+	}
+	switch code := base.String(); code {
+	// 未確定 (und)、複数 (mul)、言語でない (zxx) は言語として扱わない
+	case "und", "mul", "zxx":
+		return ""
+	default:
+		return Language(code)
+	}
+}
+
+// ParseLanguages は codes を重複を除いて Language に変換する
+func ParseLanguages(codes []string) []Language {
+	var languages []Language
+	for _, code := range codes {
+		if l := ParseLanguage(code); l != "" && !slices.Contains(languages, l) {
+			languages = append(languages, l)
+		}
+	}
+	return languages
+}
+
+// 文字体系だけで言語が決まるもの
+// ラテン文字と漢字だけでは言語を決められないので含めない
+var scriptLanguages = []struct {
+	script   *unicode.RangeTable
+	language Language
+}{
+	{unicode.Hiragana, Japanese},
+	{unicode.Katakana, Japanese},
+	{unicode.Hangul, Korean},
+	{unicode.Thai, "th"},
+	{unicode.Greek, "el"},
+	{unicode.Hebrew, "he"},
+	{unicode.Arabic, "ar"},
+	{unicode.Devanagari, "hi"},
+	{unicode.Cyrillic, "ru"},
+}
+
+// DetectLanguage はタイトルなどに使われている文字から言語を推測する
+// 仮名は漢字と一緒に使われても日本語とし、推測できない場合は空を返す
+func DetectLanguage(s string) Language {
+	counts := make(map[Language]int)
+	for _, r := range s {
+		for _, sl := range scriptLanguages {
+			if unicode.Is(sl.script, r) {
+				counts[sl.language]++
+				break
+			}
+		}
+	}
+	if counts[Japanese] > 0 {
+		return Japanese
+	}
+	var detected Language
+	for _, sl := range scriptLanguages {
+		if counts[sl.language] > counts[detected] {
+			detected = sl.language
+		}
+	}
+	return detected
+}
@@ -5,26 +5,180 @@ import (
 	"time"
 )
 
-//go:generate go run github.com/dmarkham/enumer -type=Language
-type Language int32
+//go:generate go run github.com/dmarkham/enumer -type=IdentifierType
+type IdentifierType int32
 
 const (
-	UNKOWN Language = iota
-	JP
-	EN
+	ISBN IdentifierType = iota
+	// JPNO は全国書誌番号
+	JPNO
+	NCID
+	ASIN
+	ISSN
+	OCLC
 )
 
+//go:generate go run github.com/dmarkham/enumer -type=ImageOrigin -trimprefix=Image
+type ImageOrigin int32
+
+const (
+	// ImageProvider はプロバイダーから取得した表紙
+	ImageProvider ImageOrigin = iota
+	// ImageUser は利用者がアップロードした表紙で、プロバイダーの表紙で置き換えない
+	ImageUser
+	// ImageGenerated は表紙が無い本に作った仮の表紙で、本物の表紙が見つかれば置き換える
+	ImageGenerated
+	// ImageSelected は編集者が候補から選んだ表紙で、プロバイダーの表紙で置き換えない
+	ImageSelected
+)
+
+// Locked はプロバイダーの表紙で置き換えない取得元かを返す
+func (o ImageOrigin) Locked() bool {
+	return o == ImageUser || o == ImageSelected
+}
+
+//go:generate go run github.com/dmarkham/enumer -type=AttachmentKind -trimprefix=Attachment
+type AttachmentKind int32
+
+const (
+	// AttachmentOther はどれにも当てはまらない写真
+	AttachmentOther AttachmentKind = iota
+	AttachmentBackCover
+	AttachmentSpine
+	// AttachmentInterior は本文やサインのあるページなど中の写真
+	AttachmentInterior
+	// AttachmentDamage は傷や汚れの写真
+	AttachmentDamage
+)
+
+//go:generate go run github.com/dmarkham/enumer -type=ContributorRole
+type ContributorRole int32
+
+const (
+	Author ContributorRole = iota
+	Translator
+	Illustrator
+	Editor
+	// OriginalCreator は原作者
+	OriginalCreator
+)
+
+// Contributor は著者や訳者など本に関わった人と役割
+// AuthorID は保存時に名前から解決した AuthorRecord の ID (未解決の場合は 0)
+type Contributor struct {
+	Name string
+	// Reading はプロバイダーが返した名前の読み (カタカナなど)
+	Reading  string
+	Role     ContributorRole
+	AuthorID int64
+}
+
+// AuthorRecord は同じ人物の表記ゆれをまとめた典拠レコード
+type AuthorRecord struct {
+	ID int64
+	// Name は代表の表記
+	Name    string
+	Reading string
+	Aliases []string
+}
+
+type Identifier struct {
+	Type  IdentifierType
+	Value string
+}
+
 type Info struct {
+	// ID は本の内部 ID で、ISBN がある本は ISBN と同じ
+	ID          string
 	ISBN        string
+	Identifiers []Identifier
 	Title       string
-	Authors     []string
-	Description string
-	Publishdate time.Time
-	Language    Language
-	Image       Image
+	// TitleReading は書名の読み (並べ替え用で、分からない場合は空)
+	TitleReading string
+	Contributors []Contributor
+	Description  string
+	Publishdate  Date
+	// Languages は本文の言語 (対訳本などは複数)
+	Languages []Language
+	Image     Image
+	// Covers はプロバイダーが返した表紙の候補 (Image.Source はこの中から選ばれる)
+	Covers    []CoverCandidate
+	Publisher string
+	Pages     int
+	Subjects  []string
+	// Edition は "第2版" や "2nd ed." のような版表示
+	Edition string
+	Series  string
+	Price   Price
+	// NDC は日本十進分類法の分類記号 ("913.6" など)
+	NDC string
+}
+
+// Price は定価 (Currency は ISO 4217 の通貨コード)
+type Price struct {
+	Amount   float64
+	Currency string
 }
 
 type Image struct {
 	Source url.URL
 	Path   string
+	// Color は表紙の代表色 ("#rrggbb")、Blurhash は読み込み中に表示するぼかし画像
+	Color    string
+	Blurhash string
+	Origin   ImageOrigin
+}
+
+// CoverCandidate はプロバイダーが返した表紙の候補
+type CoverCandidate struct {
+	// Source はプロバイダーの名前
+	Source string
+	// Label はプロバイダーでの大きさの区別 ("thumbnail", "large" など)
+	Label string
+	URL   url.URL
+	// Width と Height は画像を取得して測った大きさ (まだ測っていない場合は 0)
+	Width  int
+	Height int
+}
+
+// Attachment は表紙の他に本に付けた写真 (裏表紙、背、サイン本のページなど)
+// Position の順に並べて表示する
+type Attachment struct {
+	ID       string
+	BookID   string
+	Position int
+	Kind     AttachmentKind
+	Caption  string
+	Width    int
+	Height   int
+	// Path は画像のキーか署名付き URL (ObjectStore から取得する)
+	Path      string
+	CreatedAt time.Time
+}
+
+// CacheEntry はプロバイダーのレスポンスのキャッシュ
+// NotFound の場合 Response は空
+type CacheEntry struct {
+	Source   string
+	ISBN     string
+	Response []byte
+	NotFound bool
+	// Schema は Response の形式の版 (古い版のエントリは使わない)
+	Schema    int
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// Query はキーワードでカタログを探すときの条件
+type Query struct {
+	Title  string
+	Author string
+}
+
+// Filter は登録済みの本を絞り込む条件
+// 空のフィールドはその条件で絞らない
+type Filter struct {
+	Title     string
+	Publisher string
+	Subject   string
 }
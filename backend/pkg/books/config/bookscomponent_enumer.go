@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _BooksComponentName = "GoogleNDL"
+const _BooksComponentName = "GoogleNDLOpenLibraryOpenBDHTTP"
 
-var _BooksComponentIndex = [...]uint8{0, 6, 9}
+var _BooksComponentIndex = [...]uint8{0, 6, 9, 20, 26, 30}
 
-const _BooksComponentLowerName = "googlendl"
+const _BooksComponentLowerName = "googlendlopenlibraryopenbdhttp"
 
 func (i BooksComponent) String() string {
 	if i >= BooksComponent(len(_BooksComponentIndex)-1) {
@@ -26,20 +26,32 @@ func _BooksComponentNoOp() {
 	var x [1]struct{}
 	_ = x[Google-(0)]
 	_ = x[NDL-(1)]
+	_ = x[OpenLibrary-(2)]
+	_ = x[OpenBD-(3)]
+	_ = x[HTTP-(4)]
 }
 
-var _BooksComponentValues = []BooksComponent{Google, NDL}
+var _BooksComponentValues = []BooksComponent{Google, NDL, OpenLibrary, OpenBD, HTTP}
 
 var _BooksComponentNameToValueMap = map[string]BooksComponent{
-	_BooksComponentName[0:6]:      Google,
-	_BooksComponentLowerName[0:6]: Google,
-	_BooksComponentName[6:9]:      NDL,
-	_BooksComponentLowerName[6:9]: NDL,
+	_BooksComponentName[0:6]:        Google,
+	_BooksComponentLowerName[0:6]:   Google,
+	_BooksComponentName[6:9]:        NDL,
+	_BooksComponentLowerName[6:9]:   NDL,
+	_BooksComponentName[9:20]:       OpenLibrary,
+	_BooksComponentLowerName[9:20]:  OpenLibrary,
+	_BooksComponentName[20:26]:      OpenBD,
+	_BooksComponentLowerName[20:26]: OpenBD,
+	_BooksComponentName[26:30]:      HTTP,
+	_BooksComponentLowerName[26:30]: HTTP,
 }
 
 var _BooksComponentNames = []string{
 	_BooksComponentName[0:6],
 	_BooksComponentName[6:9],
+	_BooksComponentName[9:20],
+	_BooksComponentName[20:26],
+	_BooksComponentName[26:30],
 }
 
 // BooksComponentString retrieves an enum value from the enum constants string name.
@@ -1,8 +1,17 @@
 package booksconfig
 
+import "time"
+
 type Config struct {
-	Kind   []BooksComponent  `yaml:"kind"`
-	Google GoogleBooksConfig `yaml:"google"`
+	Kind        []BooksComponent       `yaml:"kind"`
+	Google      GoogleBooksConfig      `yaml:"google"`
+	NDL         NDLBooksConfig         `yaml:"ndl"`
+	OpenLibrary OpenLibraryBooksConfig `yaml:"openlibrary"`
+	OpenBD      OpenBDBooksConfig      `yaml:"openbd"`
+	HTTP        []HTTPBooksConfig      `yaml:"http"`
+	Merge       MergeConfig            `yaml:"merge"`
+	Cache       CacheConfig            `yaml:"cache"`
+	Fixture     FixtureConfig          `yaml:"fixture"`
 }
 
 //go:generate go run github.com/dmarkham/enumer -type=BooksComponent -yaml
@@ -11,8 +20,143 @@ type BooksComponent uint32
 const (
 	Google BooksComponent = iota
 	NDL
+	OpenLibrary
+	OpenBD
+	HTTP
 )
 
 type GoogleBooksConfig struct {
 	APIKey string `yaml:"api_key"`
+	// 空の場合は https://books.googleapis.com/
+	BaseURL string `yaml:"base_url"`
+}
+
+type NDLBooksConfig struct {
+	// 空の場合は https://ndlsearch.ndl.go.jp
+	BaseURL string `yaml:"base_url"`
+}
+
+type OpenLibraryBooksConfig struct {
+	// 空の場合は https://openlibrary.org
+	BaseURL string `yaml:"base_url"`
+	// 空の場合は https://covers.openlibrary.org
+	CoverURL string `yaml:"cover_url"`
+}
+
+type OpenBDBooksConfig struct {
+	// 空の場合は https://api.openbd.jp
+	BaseURL string `yaml:"base_url"`
+}
+
+// HTTPBooksConfig は設定だけで追加できる HTTP のプロバイダー
+// URL と Headers の {isbn} と {api_key} は置換される
+type HTTPBooksConfig struct {
+	Name    string            `yaml:"name"`
+	URL     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers"`
+	APIKey  string            `yaml:"api_key"`
+	Format  HTTPFormat        `yaml:"format"`
+	Fields  HTTPFieldsConfig  `yaml:"fields"`
+	// Publishdate の解析に使う time.Parse のレイアウト (空の場合は 2006-01-02, 2006-01, 2006)
+	// 日付の精度は一致したレイアウトに含まれる年・月・日で決まる
+	DateLayouts []string `yaml:"date_layouts"`
+}
+
+//go:generate go run github.com/dmarkham/enumer -type=HTTPFormat -yaml
+type HTTPFormat uint32
+
+const (
+	JSON HTTPFormat = iota
+	XML
+)
+
+// HTTPFieldsConfig は Format が JSON の場合は JSONPath、XML の場合は XPath で書く
+type HTTPFieldsConfig struct {
+	Title       string `yaml:"title"`
+	Authors     string `yaml:"authors"`
+	Description string `yaml:"description"`
+	Publishdate string `yaml:"publishdate"`
+	Language    string `yaml:"language"`
+	Image       string `yaml:"image"`
+	Publisher   string `yaml:"publisher"`
+	Pages       string `yaml:"pages"`
+	Subjects    string `yaml:"subjects"`
+	Edition     string `yaml:"edition"`
+	Series      string `yaml:"series"`
+	NDC         string `yaml:"ndc"`
+	// Price は "1,800円" や "19.99" のような金額 (数字以外は無視する)
+	Price string `yaml:"price"`
+	// PriceCurrency は ISO 4217 の通貨コード (空の場合は Price に "円" があれば JPY)
+	PriceCurrency string `yaml:"price_currency"`
+	// Identifiers は識別子の種類 (JPNO, NCID など) ごとの式
+	Identifiers map[string]string `yaml:"identifiers"`
+	// Contributors は役割 (Translator, Illustrator など) ごとの式
+	Contributors map[string]string `yaml:"contributors"`
 }
+
+// FixtureConfig はプロバイダーへのリクエストを Dir に記録・再生する設定
+// オフラインでの開発やテストに使う
+type FixtureConfig struct {
+	Mode FixtureMode `yaml:"mode"`
+	Dir  string      `yaml:"dir"`
+}
+
+//go:generate go run github.com/dmarkham/enumer -type=FixtureMode -yaml
+type FixtureMode uint32
+
+const (
+	Off FixtureMode = iota
+	// Record は実際にリクエストしてレスポンスを記録する
+	Record
+	// Replay は記録したレスポンスだけを返す
+	Replay
+)
+
+// CacheConfig はプロバイダーのレスポンスを DB にキャッシュする設定
+type CacheConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// 空の場合は 720h
+	TTL time.Duration `yaml:"ttl"`
+	// 見つからなかった ISBN を覚えておく期間 (空の場合は 24h)
+	NegativeTTL time.Duration `yaml:"negative_ttl"`
+}
+
+// MergeConfig は各フィールドをどのプロバイダーの値で埋めるかを決める
+type MergeConfig struct {
+	Title       FieldPolicy `yaml:"title"`
+	Authors     FieldPolicy `yaml:"authors"`
+	Description FieldPolicy `yaml:"description"`
+	Publishdate FieldPolicy `yaml:"publishdate"`
+	Language    FieldPolicy `yaml:"language"`
+	Image       FieldPolicy `yaml:"image"`
+	Publisher   FieldPolicy `yaml:"publisher"`
+	Pages       FieldPolicy `yaml:"pages"`
+	Subjects    FieldPolicy `yaml:"subjects"`
+	Edition     FieldPolicy `yaml:"edition"`
+	Series      FieldPolicy `yaml:"series"`
+	Price       FieldPolicy `yaml:"price"`
+	NDC         FieldPolicy `yaml:"ndc"`
+}
+
+// FieldPolicy は Priority に並べたプロバイダーから Strategy で値を選び、
+// 見つからなければ残りのプロバイダーから Fallback で選ぶ
+// Priority が空の場合は kind の順に全プロバイダーを Strategy で選ぶ
+type FieldPolicy struct {
+	Priority []BooksComponent `yaml:"priority"`
+	Strategy MergeStrategy    `yaml:"strategy"`
+	Fallback MergeStrategy    `yaml:"fallback"`
+}
+
+//go:generate go run github.com/dmarkham/enumer -type=MergeStrategy -yaml
+type MergeStrategy uint32
+
+const (
+	// First は最初に値を返したプロバイダーを採用する
+	First MergeStrategy = iota
+	// Longest は最も長い値を採用する (title, authors, description)
+	Longest
+	// Newest は最も新しい日付を採用する (publishdate)
+	Newest
+	// None は値を採用しない (Fallback で残りのプロバイダーを無視する場合に使う)
+	None
+)
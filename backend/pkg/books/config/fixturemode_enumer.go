@@ -0,0 +1,99 @@
+// Code generated by "enumer -type=FixtureMode -yaml"; DO NOT EDIT.
+
+package booksconfig
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _FixtureModeName = "OffRecordReplay"
+
+var _FixtureModeIndex = [...]uint8{0, 3, 9, 15}
+
+const _FixtureModeLowerName = "offrecordreplay"
+
+func (i FixtureMode) String() string {
+	if i >= FixtureMode(len(_FixtureModeIndex)-1) {
+		return fmt.Sprintf("FixtureMode(%d)", i)
+	}
+	return _FixtureModeName[_FixtureModeIndex[i]:_FixtureModeIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _FixtureModeNoOp() {
+	var x [1]struct{}
+	_ = x[Off-(0)]
+	_ = x[Record-(1)]
+	_ = x[Replay-(2)]
+}
+
+var _FixtureModeValues = []FixtureMode{Off, Record, Replay}
+
+var _FixtureModeNameToValueMap = map[string]FixtureMode{
+	_FixtureModeName[0:3]:       Off,
+	_FixtureModeLowerName[0:3]:  Off,
+	_FixtureModeName[3:9]:       Record,
+	_FixtureModeLowerName[3:9]:  Record,
+	_FixtureModeName[9:15]:      Replay,
+	_FixtureModeLowerName[9:15]: Replay,
+}
+
+var _FixtureModeNames = []string{
+	_FixtureModeName[0:3],
+	_FixtureModeName[3:9],
+	_FixtureModeName[9:15],
+}
+
+// FixtureModeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func FixtureModeString(s string) (FixtureMode, error) {
+	if val, ok := _FixtureModeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _FixtureModeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to FixtureMode values", s)
+}
+
+// FixtureModeValues returns all values of the enum
+func FixtureModeValues() []FixtureMode {
+	return _FixtureModeValues
+}
+
+// FixtureModeStrings returns a slice of all String values of the enum
+func FixtureModeStrings() []string {
+	strs := make([]string, len(_FixtureModeNames))
+	copy(strs, _FixtureModeNames)
+	return strs
+}
+
+// IsAFixtureMode returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i FixtureMode) IsAFixtureMode() bool {
+	for _, v := range _FixtureModeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalYAML implements a YAML Marshaler for FixtureMode
+func (i FixtureMode) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for FixtureMode
+func (i *FixtureMode) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = FixtureModeString(s)
+	return err
+}
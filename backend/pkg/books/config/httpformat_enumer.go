@@ -0,0 +1,95 @@
+// Code generated by "enumer -type=HTTPFormat -yaml"; DO NOT EDIT.
+
+package booksconfig
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _HTTPFormatName = "JSONXML"
+
+var _HTTPFormatIndex = [...]uint8{0, 4, 7}
+
+const _HTTPFormatLowerName = "jsonxml"
+
+func (i HTTPFormat) String() string {
+	if i >= HTTPFormat(len(_HTTPFormatIndex)-1) {
+		return fmt.Sprintf("HTTPFormat(%d)", i)
+	}
+	return _HTTPFormatName[_HTTPFormatIndex[i]:_HTTPFormatIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _HTTPFormatNoOp() {
+	var x [1]struct{}
+	_ = x[JSON-(0)]
+	_ = x[XML-(1)]
+}
+
+var _HTTPFormatValues = []HTTPFormat{JSON, XML}
+
+var _HTTPFormatNameToValueMap = map[string]HTTPFormat{
+	_HTTPFormatName[0:4]:      JSON,
+	_HTTPFormatLowerName[0:4]: JSON,
+	_HTTPFormatName[4:7]:      XML,
+	_HTTPFormatLowerName[4:7]: XML,
+}
+
+var _HTTPFormatNames = []string{
+	_HTTPFormatName[0:4],
+	_HTTPFormatName[4:7],
+}
+
+// HTTPFormatString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func HTTPFormatString(s string) (HTTPFormat, error) {
+	if val, ok := _HTTPFormatNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _HTTPFormatNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to HTTPFormat values", s)
+}
+
+// HTTPFormatValues returns all values of the enum
+func HTTPFormatValues() []HTTPFormat {
+	return _HTTPFormatValues
+}
+
+// HTTPFormatStrings returns a slice of all String values of the enum
+func HTTPFormatStrings() []string {
+	strs := make([]string, len(_HTTPFormatNames))
+	copy(strs, _HTTPFormatNames)
+	return strs
+}
+
+// IsAHTTPFormat returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i HTTPFormat) IsAHTTPFormat() bool {
+	for _, v := range _HTTPFormatValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalYAML implements a YAML Marshaler for HTTPFormat
+func (i HTTPFormat) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for HTTPFormat
+func (i *HTTPFormat) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = HTTPFormatString(s)
+	return err
+}
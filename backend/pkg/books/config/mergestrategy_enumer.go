@@ -0,0 +1,103 @@
+// Code generated by "enumer -type=MergeStrategy -yaml"; DO NOT EDIT.
+
+package booksconfig
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _MergeStrategyName = "FirstLongestNewestNone"
+
+var _MergeStrategyIndex = [...]uint8{0, 5, 12, 18, 22}
+
+const _MergeStrategyLowerName = "firstlongestnewestnone"
+
+func (i MergeStrategy) String() string {
+	if i >= MergeStrategy(len(_MergeStrategyIndex)-1) {
+		return fmt.Sprintf("MergeStrategy(%d)", i)
+	}
+	return _MergeStrategyName[_MergeStrategyIndex[i]:_MergeStrategyIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _MergeStrategyNoOp() {
+	var x [1]struct{}
+	_ = x[First-(0)]
+	_ = x[Longest-(1)]
+	_ = x[Newest-(2)]
+	_ = x[None-(3)]
+}
+
+var _MergeStrategyValues = []MergeStrategy{First, Longest, Newest, None}
+
+var _MergeStrategyNameToValueMap = map[string]MergeStrategy{
+	_MergeStrategyName[0:5]:        First,
+	_MergeStrategyLowerName[0:5]:   First,
+	_MergeStrategyName[5:12]:       Longest,
+	_MergeStrategyLowerName[5:12]:  Longest,
+	_MergeStrategyName[12:18]:      Newest,
+	_MergeStrategyLowerName[12:18]: Newest,
+	_MergeStrategyName[18:22]:      None,
+	_MergeStrategyLowerName[18:22]: None,
+}
+
+var _MergeStrategyNames = []string{
+	_MergeStrategyName[0:5],
+	_MergeStrategyName[5:12],
+	_MergeStrategyName[12:18],
+	_MergeStrategyName[18:22],
+}
+
+// MergeStrategyString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func MergeStrategyString(s string) (MergeStrategy, error) {
+	if val, ok := _MergeStrategyNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _MergeStrategyNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to MergeStrategy values", s)
+}
+
+// MergeStrategyValues returns all values of the enum
+func MergeStrategyValues() []MergeStrategy {
+	return _MergeStrategyValues
+}
+
+// MergeStrategyStrings returns a slice of all String values of the enum
+func MergeStrategyStrings() []string {
+	strs := make([]string, len(_MergeStrategyNames))
+	copy(strs, _MergeStrategyNames)
+	return strs
+}
+
+// IsAMergeStrategy returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i MergeStrategy) IsAMergeStrategy() bool {
+	for _, v := range _MergeStrategyValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalYAML implements a YAML Marshaler for MergeStrategy
+func (i MergeStrategy) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for MergeStrategy
+func (i *MergeStrategy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = MergeStrategyString(s)
+	return err
+}
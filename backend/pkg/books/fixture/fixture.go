@@ -0,0 +1,200 @@
+package booksfixture
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+)
+
+// 記録しないクエリパラメータ (API キーなど)
+var redactedParams = []string{"key", "api_key", "apikey", "appid"}
+
+// Fixture は記録された一つのレスポンス
+type Fixture struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Status int         `json:"status"`
+	Header http.Header `json:"header"`
+	Body   string      `json:"body"`
+}
+
+// Transport はリクエストを Dir に記録・再生する http.RoundTripper
+type Transport struct {
+	mode booksconfig.FixtureMode
+	dir  string
+	next http.RoundTripper
+}
+
+func NewTransport(config booksconfig.FixtureConfig, next http.RoundTripper) (*Transport, error) {
+	if config.Dir == "" {
+		return nil, fmt.Errorf("fixture dir is empty")
+	}
+	if config.Mode == booksconfig.Record {
+		if err := os.MkdirAll(config.Dir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create fixture dir: %w", err)
+		}
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Transport{
+		mode: config.Mode,
+		dir:  config.Dir,
+		next: next,
+	}, nil
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch t.mode {
+	case booksconfig.Record:
+		return t.record(req)
+	case booksconfig.Replay:
+		return t.replay(req)
+	default:
+		return t.next.RoundTrip(req)
+	}
+}
+
+func (t *Transport) record(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %w", err)
+	}
+
+	fixture := Fixture{
+		Method: req.Method,
+		URL:    Key(req.URL),
+		Status: resp.StatusCode,
+		Header: http.Header{},
+		Body:   string(body),
+	}
+	for _, h := range []string{"Content-Type", "Location"} {
+		if v := resp.Header.Get(h); v != "" {
+			fixture.Header.Set(h, v)
+		}
+	}
+	b, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode fixture: %w", err)
+	}
+	if err := os.WriteFile(t.path(req), b, 0o644); err != nil {
+		return nil, fmt.Errorf("failed to write fixture: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+func (t *Transport) replay(req *http.Request) (*http.Response, error) {
+	b, err := os.ReadFile(t.path(req))
+	if err != nil {
+		return nil, fmt.Errorf("no fixture for %s %s: %w", req.Method, Key(req.URL), err)
+	}
+	var fixture Fixture
+	if err := json.Unmarshal(b, &fixture); err != nil {
+		return nil, fmt.Errorf("failed to decode fixture: %w", err)
+	}
+	return fixture.Response(req), nil
+}
+
+// Response は記録したレスポンスを req への応答として返す
+func (f Fixture) Response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.Status, http.StatusText(f.Status)),
+		StatusCode:    f.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        f.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(f.Body)),
+		ContentLength: int64(len(f.Body)),
+		Request:       req,
+	}
+}
+
+func (t *Transport) path(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Method + " " + Key(req.URL)))
+	return filepath.Join(t.dir, hex.EncodeToString(sum[:8])+".json")
+}
+
+// Key は API キーを除いてクエリを並べ替えた URL を返す
+func Key(u *url.URL) string {
+	k := *u
+	query := k.Query()
+	for _, p := range redactedParams {
+		query.Del(p)
+	}
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, key := range keys {
+		for _, v := range query[key] {
+			parts = append(parts, url.QueryEscape(key)+"="+url.QueryEscape(v))
+		}
+	}
+	k.RawQuery = strings.Join(parts, "&")
+	k.User = nil
+	return k.String()
+}
+
+// NewServer は upstream へのリクエストを記録・再生するサーバーを起動する
+// プロバイダーの base URL に Server.URL を渡すとオフラインでテストできる
+func NewServer(config booksconfig.FixtureConfig, upstream string) (*httptest.Server, error) {
+	u, err := url.Parse(upstream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse upstream: %w", err)
+	}
+	transport, err := NewTransport(config, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		target := *u
+		target.Path = strings.TrimSuffix(u.Path, "/") + r.URL.Path
+		target.RawQuery = r.URL.RawQuery
+
+		req, err := http.NewRequestWithContext(r.Context(), r.Method, target.String(), r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.Header = r.Header.Clone()
+
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+
+		for k, v := range resp.Header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(resp.StatusCode)
+		if _, err := io.Copy(w, resp.Body); err != nil {
+			return
+		}
+	})), nil
+}
@@ -3,9 +3,10 @@ package googlebooks
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
 	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
 	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
@@ -14,24 +15,60 @@ import (
 )
 
 type GoogleBooks struct {
+	lg  *slog.Logger
 	svc *api.Service
 
 	closer context.CancelFunc
 }
 
-func NewGoogleBooks(config booksconfig.GoogleBooksConfig) (*GoogleBooks, error) {
+func NewGoogleBooks(lg *slog.Logger, config booksconfig.GoogleBooksConfig, client *http.Client) (*GoogleBooks, error) {
+	var opts []option.ClientOption
+	if client != nil {
+		// WithHTTPClient を使うと WithAPIKey は無視されるので自分で付ける
+		opts = append(opts, option.WithHTTPClient(&http.Client{
+			Transport: &apiKeyTransport{key: config.APIKey, next: client.Transport},
+			Timeout:   client.Timeout,
+		}))
+	} else {
+		opts = append(opts, option.WithAPIKey(config.APIKey))
+	}
+	if config.BaseURL != "" {
+		opts = append(opts, option.WithEndpoint(config.BaseURL))
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
-	svc, err := api.NewService(ctx, option.WithAPIKey(config.APIKey))
+	svc, err := api.NewService(ctx, opts...)
 	if err != nil {
 		cancel()
 		return nil, fmt.Errorf("failed to create service: %w", err)
 	}
 	return &GoogleBooks{
+		lg:     lg.With(slog.String("Package", "google")),
 		svc:    svc,
 		closer: cancel,
 	}, nil
 }
 
+type apiKeyTransport struct {
+	key  string
+	next http.RoundTripper
+}
+
+func (t *apiKeyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if t.key == "" {
+		return next.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	query := req.URL.Query()
+	query.Set("key", t.key)
+	req.URL.RawQuery = query.Encode()
+	return next.RoundTrip(req)
+}
+
 func (s *GoogleBooks) Close() error {
 	s.closer()
 	return nil
@@ -43,7 +80,7 @@ func (s *GoogleBooks) GetInfo(isbn string) (*bookscommon.Info, error) {
 		return nil, fmt.Errorf("failed to request: %w", err)
 	}
 	if len(volumes.Items) == 0 {
-		return nil, fmt.Errorf("not found book")
+		return nil, fmt.Errorf("%w: %s", bookscommon.ErrNotFoundBook, isbn)
 	}
 
 	volume := volumes.Items[0]
@@ -92,11 +129,20 @@ func (s *GoogleBooks) GetInfo(isbn string) (*bookscommon.Info, error) {
 	}
 
 	book := &bookscommon.Info{
-		ISBN:        isbn,
-		Title:       title,
-		Authors:     volume.VolumeInfo.Authors,
-		Description: desc,
-		Language:    StringToLanguage(volume.VolumeInfo.Language),
+		ISBN:         isbn,
+		Title:        title,
+		Contributors: bookscommon.Authors(volume.VolumeInfo.Authors),
+		Description:  desc,
+		Languages:    StringToLanguages(volume.VolumeInfo.Language),
+		Publisher:    volume.VolumeInfo.Publisher,
+		Pages:        int(volume.VolumeInfo.PageCount),
+		Subjects:     volume.VolumeInfo.Categories,
+	}
+	if volume.SaleInfo != nil && volume.SaleInfo.ListPrice != nil {
+		book.Price = bookscommon.Price{
+			Amount:   volume.SaleInfo.ListPrice.Amount,
+			Currency: volume.SaleInfo.ListPrice.CurrencyCode,
+		}
 	}
 
 	date, err := StringToDate(volume.VolumeInfo.PublishedDate)
@@ -104,40 +150,114 @@ func (s *GoogleBooks) GetInfo(isbn string) (*bookscommon.Info, error) {
 		book.Publishdate = date
 	}
 
-	var u *url.URL
-	if volume.VolumeInfo.ImageLinks != nil {
-		u, err = url.Parse(volume.VolumeInfo.ImageLinks.Thumbnail)
-		if err == nil {
+	// 一覧の ImageLinks はたいてい thumbnail までなので、大きい表紙が無い場合だけ選んだ巻を取り直す
+	links := volume.VolumeInfo.ImageLinks
+	if links == nil || (links.ExtraLarge == "" && links.Large == "" && links.Medium == "") {
+		full, err := s.svc.Volumes.Get(volume.Id).Do()
+		switch {
+		case err != nil:
+			s.lg.Warn("failed to get volume", slog.String("isbn", isbn), slog.String("volume", volume.Id), slog.String("err", err.Error()))
+		case full.VolumeInfo != nil && full.VolumeInfo.ImageLinks != nil:
+			links = full.VolumeInfo.ImageLinks
+		}
+	}
+	if links != nil {
+		if u, err := url.Parse(links.Thumbnail); err == nil {
 			book.Image.Source = *u
 		}
+		book.Covers = coverCandidates(links)
 	}
 
 	return book, nil
 }
 
-func StringToDate(s string) (time.Time, error) {
-	var date time.Time
-	if s == "" {
-		return date, fmt.Errorf("date is empty")
+// coverCandidates は ImageLinks の各大きさを大きい順に表紙の候補にする
+func coverCandidates(links *api.VolumeVolumeInfoImageLinks) []bookscommon.CoverCandidate {
+	var candidates []bookscommon.CoverCandidate
+	for _, link := range []struct {
+		label string
+		url   string
+	}{
+		{"extraLarge", links.ExtraLarge},
+		{"large", links.Large},
+		{"medium", links.Medium},
+		{"small", links.Small},
+		{"thumbnail", links.Thumbnail},
+		{"smallThumbnail", links.SmallThumbnail},
+	} {
+		if link.url == "" {
+			continue
+		}
+		u, err := url.Parse(link.url)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, bookscommon.CoverCandidate{Label: link.label, URL: *u})
+	}
+	return candidates
+}
+
+const searchMaxResults = 20
+
+func (s *GoogleBooks) Search(query bookscommon.Query) ([]bookscommon.Info, error) {
+	var terms []string
+	if query.Title != "" {
+		terms = append(terms, "intitle:"+query.Title)
+	}
+	if query.Author != "" {
+		terms = append(terms, "inauthor:"+query.Author)
 	}
-	if len(s) == 4 {
-		s = s + "-01"
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("query is empty")
 	}
-	shortForm := "2006-01"
-	date, err := time.Parse(shortForm, s)
+
+	volumes, err := s.svc.Volumes.List(strings.Join(terms, " ")).MaxResults(searchMaxResults).PrintType("books").Do()
 	if err != nil {
-		return date, fmt.Errorf("failed to convert date: %w", err)
+		return nil, fmt.Errorf("failed to request: %w", err)
 	}
-	return date, nil
-}
 
-func StringToLanguage(s string) bookscommon.Language {
-	switch s {
-	case "en":
-		return bookscommon.EN
-	case "ja":
-		return bookscommon.JP
-	default:
-		return bookscommon.UNKOWN
+	var infos []bookscommon.Info
+	for _, volume := range volumes.Items {
+		if volume.VolumeInfo == nil {
+			continue
+		}
+		var isbn string
+		for _, id := range volume.VolumeInfo.IndustryIdentifiers {
+			if id.Type == "ISBN_13" || id.Type == "ISBN_10" && isbn == "" {
+				isbn = bookscommon.NormalizeISBN(id.Identifier)
+			}
+		}
+		if isbn == "" {
+			continue
+		}
+
+		info := bookscommon.Info{
+			ISBN:         isbn,
+			Title:        strings.TrimSpace(volume.VolumeInfo.Title + " " + volume.VolumeInfo.Subtitle),
+			Contributors: bookscommon.Authors(volume.VolumeInfo.Authors),
+			Languages:    StringToLanguages(volume.VolumeInfo.Language),
+			Publisher:    volume.VolumeInfo.Publisher,
+			Pages:        int(volume.VolumeInfo.PageCount),
+			Subjects:     volume.VolumeInfo.Categories,
+		}
+		if date, err := StringToDate(volume.VolumeInfo.PublishedDate); err == nil {
+			info.Publishdate = date
+		}
+		if volume.VolumeInfo.ImageLinks != nil {
+			if u, err := url.Parse(volume.VolumeInfo.ImageLinks.Thumbnail); err == nil {
+				info.Image.Source = *u
+			}
+		}
+		infos = append(infos, info)
 	}
+	return infos, nil
+}
+
+func StringToDate(s string) (bookscommon.Date, error) {
+	return bookscommon.ParseDate(s)
+}
+
+// StringToLanguages は volumeInfo.language (ISO 639-1 のコード) を変換する
+func StringToLanguages(s string) []bookscommon.Language {
+	return bookscommon.ParseLanguages([]string{s})
 }
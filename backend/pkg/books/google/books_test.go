@@ -0,0 +1,165 @@
+package googlebooks
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+	booksfixture "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/fixture"
+)
+
+func newTestGoogleBooks(t *testing.T) *GoogleBooks {
+	t.Helper()
+	transport, err := booksfixture.NewTransport(booksconfig.FixtureConfig{Mode: booksconfig.Replay, Dir: "testdata"}, nil)
+	if err != nil {
+		t.Fatalf("NewTransport() error = %v", err)
+	}
+	books, err := NewGoogleBooks(slog.New(slog.NewTextHandler(io.Discard, nil)), booksconfig.GoogleBooksConfig{APIKey: "test"}, &http.Client{Transport: transport})
+	if err != nil {
+		t.Fatalf("NewGoogleBooks() error = %v", err)
+	}
+	t.Cleanup(func() { books.Close() })
+	return books
+}
+
+func TestGetInfo(t *testing.T) {
+	info, err := newTestGoogleBooks(t).GetInfo("9784101010137")
+	if err != nil {
+		t.Fatalf("GetInfo() error = %v", err)
+	}
+
+	// 同じ本の巻は副題の長いものを使う
+	if info.Title != "こころ 改版" {
+		t.Errorf("Title = %q, want %q", info.Title, "こころ 改版")
+	}
+	wantContributors := []bookscommon.Contributor{{Name: "夏目漱石", Role: bookscommon.Author}}
+	if !reflect.DeepEqual(info.Contributors, wantContributors) {
+		t.Errorf("Contributors = %+v, want %+v", info.Contributors, wantContributors)
+	}
+	if info.Publisher != "新潮社" {
+		t.Errorf("Publisher = %q, want %q", info.Publisher, "新潮社")
+	}
+	if info.Pages != 384 {
+		t.Errorf("Pages = %d, want 384", info.Pages)
+	}
+	if want := (bookscommon.Price{Amount: 440, Currency: "JPY"}); info.Price != want {
+		t.Errorf("Price = %+v, want %+v", info.Price, want)
+	}
+	if want := bookscommon.NewDate(time.Date(2004, time.March, 1, 0, 0, 0, 0, time.UTC), bookscommon.DateDay); info.Publishdate != want {
+		t.Errorf("Publishdate = %v, want %v", info.Publishdate, want)
+	}
+	if want := []bookscommon.Language{"ja"}; !reflect.DeepEqual(info.Languages, want) {
+		t.Errorf("Languages = %v, want %v", info.Languages, want)
+	}
+	if want := []string{"Fiction"}; !reflect.DeepEqual(info.Subjects, want) {
+		t.Errorf("Subjects = %v, want %v", info.Subjects, want)
+	}
+
+	var labels []string
+	for _, c := range info.Covers {
+		labels = append(labels, c.Label)
+	}
+	// 一覧に無い大きい表紙は巻を取り直して得る
+	if want := []string{"large", "medium", "small", "thumbnail", "smallThumbnail"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("Covers labels = %v, want %v", labels, want)
+	}
+	if want := "http://books.google.com/books/content?id=x0ZCEAAAQBAJ&printsec=frontcover&img=1&zoom=1&edge=curl&imgtk=AFLRE7&source=gbs_api"; info.Image.Source.String() != want {
+		t.Errorf("Image.Source = %q, want %q", info.Image.Source.String(), want)
+	}
+}
+
+// countingTransport は送ったリクエストの URL を記録する
+type countingTransport struct {
+	next http.RoundTripper
+	urls []string
+}
+
+func (c *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.urls = append(c.urls, req.URL.Path)
+	return c.next.RoundTrip(req)
+}
+
+// 一覧に大きい表紙があれば巻を取り直さない
+func TestGetInfoLargeImageLinks(t *testing.T) {
+	transport, err := booksfixture.NewTransport(booksconfig.FixtureConfig{Mode: booksconfig.Replay, Dir: "testdata"}, nil)
+	if err != nil {
+		t.Fatalf("NewTransport() error = %v", err)
+	}
+	counting := &countingTransport{next: transport}
+	books, err := NewGoogleBooks(slog.New(slog.NewTextHandler(io.Discard, nil)), booksconfig.GoogleBooksConfig{APIKey: "test"}, &http.Client{Transport: counting})
+	if err != nil {
+		t.Fatalf("NewGoogleBooks() error = %v", err)
+	}
+	t.Cleanup(func() { books.Close() })
+
+	info, err := books.GetInfo("9780141182629")
+	if err != nil {
+		t.Fatalf("GetInfo() error = %v", err)
+	}
+	if want := []string{"/books/v1/volumes"}; !reflect.DeepEqual(counting.urls, want) {
+		t.Errorf("requests = %v, want %v", counting.urls, want)
+	}
+	var labels []string
+	for _, c := range info.Covers {
+		labels = append(labels, c.Label)
+	}
+	if want := []string{"medium", "thumbnail", "smallThumbnail"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("Covers labels = %v, want %v", labels, want)
+	}
+}
+
+func TestGetInfoNotFound(t *testing.T) {
+	_, err := newTestGoogleBooks(t).GetInfo("9780000000002")
+	if !errors.Is(err, bookscommon.ErrNotFoundBook) {
+		t.Errorf("GetInfo() error = %v, want %v", err, bookscommon.ErrNotFoundBook)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	infos, err := newTestGoogleBooks(t).Search(bookscommon.Query{Title: "こころ", Author: "夏目漱石"})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	// ISBN の無い本は除く
+	if len(infos) != 2 {
+		t.Fatalf("len(Search()) = %d, want 2", len(infos))
+	}
+
+	if infos[0].ISBN != "9784101010137" {
+		t.Errorf("ISBN = %q, want %q", infos[0].ISBN, "9784101010137")
+	}
+	if infos[0].Title != "こころ 改版" {
+		t.Errorf("Title = %q, want %q", infos[0].Title, "こころ 改版")
+	}
+	if want := bookscommon.NewDate(time.Date(2004, time.March, 1, 0, 0, 0, 0, time.UTC), bookscommon.DateMonth); infos[0].Publishdate != want {
+		t.Errorf("Publishdate = %v, want %v", infos[0].Publishdate, want)
+	}
+
+	// ISBN-10 しか無い場合は ISBN-13 に揃える
+	if infos[1].ISBN != "9780141182629" {
+		t.Errorf("ISBN = %q, want %q", infos[1].ISBN, "9780141182629")
+	}
+	wantContributors := []bookscommon.Contributor{
+		{Name: "Natsume Soseki", Role: bookscommon.Author},
+		{Name: "Meredith McKinney", Role: bookscommon.Author},
+	}
+	if !reflect.DeepEqual(infos[1].Contributors, wantContributors) {
+		t.Errorf("Contributors = %+v, want %+v", infos[1].Contributors, wantContributors)
+	}
+	if want := []bookscommon.Language{"en"}; !reflect.DeepEqual(infos[1].Languages, want) {
+		t.Errorf("Languages = %v, want %v", infos[1].Languages, want)
+	}
+}
+
+func TestSearchEmptyQuery(t *testing.T) {
+	if _, err := newTestGoogleBooks(t).Search(bookscommon.Query{}); err == nil {
+		t.Error("Search() error = nil, want error")
+	}
+}
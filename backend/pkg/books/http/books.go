@@ -0,0 +1,328 @@
+package httpbooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/antchfx/xmlquery"
+	"github.com/antchfx/xpath"
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+	"github.com/ohler55/ojg/jp"
+)
+
+// query はフィールド一つ分の JSONPath か XPath
+type query struct {
+	json jp.Expr
+	xml  *xpath.Expr
+}
+
+type identifierQuery struct {
+	idType bookscommon.IdentifierType
+	query  *query
+}
+
+type contributorQuery struct {
+	role  bookscommon.ContributorRole
+	query *query
+}
+
+var pricePattern = regexp.MustCompile(`[\d,]+(\.\d+)?`)
+
+type HTTPBooks struct {
+	client *http.Client
+	name   string
+	url    string
+	apiKey string
+
+	headers     map[string]string
+	format      booksconfig.HTTPFormat
+	dateLayouts []string
+
+	title       *query
+	authors     *query
+	description *query
+	publishdate *query
+	language    *query
+	image       *query
+	publisher   *query
+	pages       *query
+	subjects    *query
+	edition     *query
+	series      *query
+	ndc         *query
+	price       *query
+	currency    *query
+
+	identifiers  []identifierQuery
+	contributors []contributorQuery
+}
+
+func NewHTTPBooks(config booksconfig.HTTPBooksConfig, client *http.Client) (*HTTPBooks, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("url is empty: %s", config.Name)
+	}
+	if !strings.Contains(config.URL, "{isbn}") {
+		return nil, fmt.Errorf("url must contain {isbn}: %s", config.Name)
+	}
+	if !config.Format.IsAHTTPFormat() {
+		return nil, fmt.Errorf("unknown format: %s", config.Format)
+	}
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+	s := &HTTPBooks{
+		client:      client,
+		name:        config.Name,
+		url:         config.URL,
+		apiKey:      config.APIKey,
+		headers:     config.Headers,
+		format:      config.Format,
+		dateLayouts: config.DateLayouts,
+	}
+	if len(s.dateLayouts) == 0 {
+		s.dateLayouts = bookscommon.DefaultDateLayouts
+	}
+
+	for _, f := range []struct {
+		name string
+		expr string
+		dst  **query
+	}{
+		{"title", config.Fields.Title, &s.title},
+		{"authors", config.Fields.Authors, &s.authors},
+		{"description", config.Fields.Description, &s.description},
+		{"publishdate", config.Fields.Publishdate, &s.publishdate},
+		{"language", config.Fields.Language, &s.language},
+		{"image", config.Fields.Image, &s.image},
+		{"publisher", config.Fields.Publisher, &s.publisher},
+		{"pages", config.Fields.Pages, &s.pages},
+		{"subjects", config.Fields.Subjects, &s.subjects},
+		{"edition", config.Fields.Edition, &s.edition},
+		{"series", config.Fields.Series, &s.series},
+		{"ndc", config.Fields.NDC, &s.ndc},
+		{"price", config.Fields.Price, &s.price},
+		{"price_currency", config.Fields.PriceCurrency, &s.currency},
+	} {
+		if f.expr == "" {
+			continue
+		}
+		q, err := s.compile(f.expr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile %s of %s: %w", f.name, config.Name, err)
+		}
+		*f.dst = q
+	}
+	if s.title == nil {
+		return nil, fmt.Errorf("title mapping is empty: %s", config.Name)
+	}
+
+	for name, expr := range config.Fields.Identifiers {
+		idType, err := bookscommon.IdentifierTypeString(name)
+		if err != nil {
+			return nil, fmt.Errorf("unknown identifier type %s of %s: %w", name, config.Name, err)
+		}
+		q, err := s.compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile identifier %s of %s: %w", name, config.Name, err)
+		}
+		s.identifiers = append(s.identifiers, identifierQuery{idType: idType, query: q})
+	}
+	slices.SortFunc(s.identifiers, func(a, b identifierQuery) int { return int(a.idType - b.idType) })
+
+	for name, expr := range config.Fields.Contributors {
+		role, err := bookscommon.ContributorRoleString(name)
+		if err != nil {
+			return nil, fmt.Errorf("unknown contributor role %s of %s: %w", name, config.Name, err)
+		}
+		q, err := s.compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile contributor %s of %s: %w", name, config.Name, err)
+		}
+		s.contributors = append(s.contributors, contributorQuery{role: role, query: q})
+	}
+	slices.SortFunc(s.contributors, func(a, b contributorQuery) int { return int(a.role - b.role) })
+
+	return s, nil
+}
+
+func (s *HTTPBooks) compile(expr string) (*query, error) {
+	switch s.format {
+	case booksconfig.JSON:
+		x, err := jp.ParseString(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSONPath: %w", err)
+		}
+		return &query{json: x}, nil
+	case booksconfig.XML:
+		x, err := xpath.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid XPath: %w", err)
+		}
+		return &query{xml: x}, nil
+	default:
+		return nil, fmt.Errorf("unknown format: %s", s.format)
+	}
+}
+
+func (s *HTTPBooks) Close() error {
+	return nil
+}
+
+func (s *HTTPBooks) GetInfo(isbn string) (*bookscommon.Info, error) {
+	replacer := strings.NewReplacer("{isbn}", url.QueryEscape(isbn), "{api_key}", url.QueryEscape(s.apiKey))
+	req, err := http.NewRequest(http.MethodGet, replacer.Replace(s.url), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	headerReplacer := strings.NewReplacer("{isbn}", isbn, "{api_key}", s.apiKey)
+	for k, v := range s.headers {
+		req.Header.Set(k, headerReplacer.Replace(v))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request %s: %w", s.name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", bookscommon.ErrNotFoundBook, isbn)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad status from %s: %s", s.name, resp.Status)
+	}
+
+	var find func(q *query) []string
+	switch s.format {
+	case booksconfig.JSON:
+		var data any
+		decoder := json.NewDecoder(resp.Body)
+		decoder.UseNumber()
+		if err := decoder.Decode(&data); err != nil {
+			return nil, fmt.Errorf("failed to decode JSON: %w", err)
+		}
+		find = func(q *query) []string {
+			var values []string
+			for _, v := range q.json.Get(data) {
+				if s := toString(v); s != "" {
+					values = append(values, s)
+				}
+			}
+			return values
+		}
+	case booksconfig.XML:
+		doc, err := xmlquery.Parse(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode XML: %w", err)
+		}
+		find = func(q *query) []string {
+			var values []string
+			for _, node := range xmlquery.QuerySelectorAll(doc, q.xml) {
+				if s := strings.TrimSpace(node.InnerText()); s != "" {
+					values = append(values, s)
+				}
+			}
+			return values
+		}
+	}
+
+	first := func(q *query) string {
+		if q == nil {
+			return ""
+		}
+		if values := find(q); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+
+	title := first(s.title)
+	if title == "" {
+		return nil, fmt.Errorf("%w: %s", bookscommon.ErrNotFoundBook, isbn)
+	}
+
+	info := &bookscommon.Info{
+		ISBN:        isbn,
+		Title:       title,
+		Description: first(s.description),
+		Publisher:   first(s.publisher),
+		Edition:     first(s.edition),
+		Series:      first(s.series),
+		NDC:         first(s.ndc),
+	}
+	if info.Description == "" {
+		info.Description = bookscommon.NoDescription
+	}
+	if s.authors != nil {
+		info.Contributors = bookscommon.Authors(find(s.authors))
+	}
+	for _, c := range s.contributors {
+		for _, name := range find(c.query) {
+			info.Contributors = append(info.Contributors, bookscommon.Contributor{Name: name, Role: c.role})
+		}
+	}
+	for _, id := range s.identifiers {
+		for _, value := range find(id.query) {
+			info.Identifiers = append(info.Identifiers, bookscommon.NormalizeIdentifier(bookscommon.Identifier{Type: id.idType, Value: value}))
+		}
+	}
+	info.Price = toPrice(first(s.price), first(s.currency))
+	if s.language != nil {
+		info.Languages = bookscommon.ParseLanguages(find(s.language))
+	}
+	if s.subjects != nil {
+		info.Subjects = find(s.subjects)
+	}
+	if pages, err := strconv.Atoi(first(s.pages)); err == nil {
+		info.Pages = pages
+	}
+	if date, err := s.stringToDate(first(s.publishdate)); err == nil {
+		info.Publishdate = date
+	}
+	if image := first(s.image); image != "" {
+		if u, err := url.Parse(image); err == nil && u.IsAbs() {
+			info.Image.Source = *u
+		}
+	}
+
+	return info, nil
+}
+
+// toPrice は "1,800円" や "19.99" のような金額を変換する
+func toPrice(amount, currency string) bookscommon.Price {
+	value, err := strconv.ParseFloat(strings.ReplaceAll(pricePattern.FindString(amount), ",", ""), 64)
+	if err != nil || value <= 0 {
+		return bookscommon.Price{}
+	}
+	if currency == "" && strings.Contains(amount, "円") {
+		currency = "JPY"
+	}
+	return bookscommon.Price{Amount: value, Currency: strings.ToUpper(currency)}
+}
+
+func (s *HTTPBooks) stringToDate(str string) (bookscommon.Date, error) {
+	return bookscommon.ParseDate(str, s.dateLayouts...)
+}
+
+func toString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	case json.Number:
+		return v.String()
+	case map[string]any, []any:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
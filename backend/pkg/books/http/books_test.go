@@ -0,0 +1,200 @@
+package httpbooks
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+)
+
+const testJSON = `{
+  "items": [{
+    "title": "こころ",
+    "authors": ["夏目漱石"],
+    "editors": ["三好行雄"],
+    "series": "新潮文庫",
+    "ndc": "913.6",
+    "price": "400円",
+    "ids": {"jpno": "20563321", "ncid": "ba66564521"}
+  }]
+}`
+
+const testXML = `<?xml version="1.0"?>
+<record>
+  <title>Kokoro</title>
+  <creator role="author">Natsume Soseki</creator>
+  <creator role="translator">Meredith McKinney</creator>
+  <price currency="usd">15.00</price>
+  <oclc>47805935</oclc>
+</record>`
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("isbn") {
+		case "9784101010137":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(testJSON))
+		case "9780142437186":
+			w.Header().Set("Content-Type", "application/xml")
+			w.Write([]byte(testXML))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGetInfo(t *testing.T) {
+	server := newTestServer(t)
+	tests := []struct {
+		name   string
+		config booksconfig.HTTPBooksConfig
+		isbn   string
+		want   bookscommon.Info
+	}{
+		{
+			name: "json",
+			config: booksconfig.HTTPBooksConfig{
+				Format: booksconfig.JSON,
+				Fields: booksconfig.HTTPFieldsConfig{
+					Title:        "$.items[0].title",
+					Authors:      "$.items[0].authors[*]",
+					Series:       "$.items[0].series",
+					NDC:          "$.items[0].ndc",
+					Price:        "$.items[0].price",
+					Identifiers:  map[string]string{"JPNO": "$.items[0].ids.jpno", "NCID": "$.items[0].ids.ncid"},
+					Contributors: map[string]string{"Editor": "$.items[0].editors[*]"},
+				},
+			},
+			isbn: "9784101010137",
+			want: bookscommon.Info{
+				ISBN:  "9784101010137",
+				Title: "こころ",
+				Identifiers: []bookscommon.Identifier{
+					{Type: bookscommon.JPNO, Value: "20563321"},
+					{Type: bookscommon.NCID, Value: "BA66564521"},
+				},
+				Contributors: []bookscommon.Contributor{
+					{Name: "夏目漱石", Role: bookscommon.Author},
+					{Name: "三好行雄", Role: bookscommon.Editor},
+				},
+				Description: bookscommon.NoDescription,
+				Series:      "新潮文庫",
+				NDC:         "913.6",
+				Price:       bookscommon.Price{Amount: 400, Currency: "JPY"},
+			},
+		},
+		{
+			name: "xml",
+			config: booksconfig.HTTPBooksConfig{
+				Format: booksconfig.XML,
+				Fields: booksconfig.HTTPFieldsConfig{
+					Title:         "/record/title",
+					Price:         "/record/price",
+					PriceCurrency: "/record/price/@currency",
+					Identifiers:   map[string]string{"OCLC": "/record/oclc"},
+					Contributors: map[string]string{
+						"Author":     `/record/creator[@role="author"]`,
+						"Translator": `/record/creator[@role="translator"]`,
+					},
+				},
+			},
+			isbn: "9780142437186",
+			want: bookscommon.Info{
+				ISBN:        "9780142437186",
+				Title:       "Kokoro",
+				Identifiers: []bookscommon.Identifier{{Type: bookscommon.OCLC, Value: "47805935"}},
+				Contributors: []bookscommon.Contributor{
+					{Name: "Natsume Soseki", Role: bookscommon.Author},
+					{Name: "Meredith McKinney", Role: bookscommon.Translator},
+				},
+				Description: bookscommon.NoDescription,
+				Price:       bookscommon.Price{Amount: 15, Currency: "USD"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.Name = tt.name
+			tt.config.URL = server.URL + "/books?isbn={isbn}"
+			s, err := NewHTTPBooks(tt.config, server.Client())
+			if err != nil {
+				t.Fatalf("NewHTTPBooks() error = %v", err)
+			}
+			got, err := s.GetInfo(tt.isbn)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("GetInfo() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetInfoNotFound(t *testing.T) {
+	server := newTestServer(t)
+	s, err := NewHTTPBooks(booksconfig.HTTPBooksConfig{
+		Name:   "json",
+		URL:    server.URL + "/books?isbn={isbn}",
+		Format: booksconfig.JSON,
+		Fields: booksconfig.HTTPFieldsConfig{Title: "$.items[0].title"},
+	}, server.Client())
+	if err != nil {
+		t.Fatalf("NewHTTPBooks() error = %v", err)
+	}
+	if _, err := s.GetInfo("9780000000002"); !errors.Is(err, bookscommon.ErrNotFoundBook) {
+		t.Errorf("GetInfo() error = %v, want %v", err, bookscommon.ErrNotFoundBook)
+	}
+}
+
+func TestNewHTTPBooksInvalidMapping(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields booksconfig.HTTPFieldsConfig
+	}{
+		{name: "no title", fields: booksconfig.HTTPFieldsConfig{Authors: "$.authors"}},
+		{name: "unknown identifier", fields: booksconfig.HTTPFieldsConfig{Title: "$.title", Identifiers: map[string]string{"DOI": "$.doi"}}},
+		{name: "unknown role", fields: booksconfig.HTTPFieldsConfig{Title: "$.title", Contributors: map[string]string{"Narrator": "$.narrators"}}},
+		{name: "bad expression", fields: booksconfig.HTTPFieldsConfig{Title: "$.title", Series: "$[?("}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewHTTPBooks(booksconfig.HTTPBooksConfig{
+				Name:   tt.name,
+				URL:    "https://catalog.example.com/books?isbn={isbn}",
+				Format: booksconfig.JSON,
+				Fields: tt.fields,
+			}, nil)
+			if err == nil {
+				t.Error("NewHTTPBooks() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestToPrice(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		want     bookscommon.Price
+	}{
+		{"1,800円", "", bookscommon.Price{Amount: 1800, Currency: "JPY"}},
+		{"1,800円 (税別)", "", bookscommon.Price{Amount: 1800, Currency: "JPY"}},
+		{"19.99", "usd", bookscommon.Price{Amount: 19.99, Currency: "USD"}},
+		{"19.99", "", bookscommon.Price{Amount: 19.99}},
+		{"0", "JPY", bookscommon.Price{}},
+		{"", "JPY", bookscommon.Price{}},
+	}
+	for _, tt := range tests {
+		if got := toPrice(tt.amount, tt.currency); got != tt.want {
+			t.Errorf("toPrice(%q, %q) = %+v, want %+v", tt.amount, tt.currency, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,280 @@
+package booksmerge
+
+import (
+	"fmt"
+	"slices"
+	"unicode/utf8"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+)
+
+// Result は一つのプロバイダーから取得した情報
+type Result struct {
+	Kind booksconfig.BooksComponent
+	Info *bookscommon.Info
+}
+
+type field struct {
+	name   string
+	policy booksconfig.FieldPolicy
+
+	empty func(info *bookscommon.Info) bool
+	copy  func(dst, src *bookscommon.Info)
+	// Longest 用 (nil の場合は使えない)
+	length func(info *bookscommon.Info) int
+	// Newest 用 (nil の場合は使えない)
+	date func(info *bookscommon.Info) bookscommon.Date
+}
+
+type Merger struct {
+	fields []field
+}
+
+func NewMerger(config booksconfig.MergeConfig) (*Merger, error) {
+	fields := []field{
+		{
+			name:   "title",
+			policy: config.Title,
+			empty:  func(info *bookscommon.Info) bool { return info.Title == "" },
+			// 読みは書名と同じプロバイダーのものを使う
+			copy:   func(dst, src *bookscommon.Info) { dst.Title, dst.TitleReading = src.Title, src.TitleReading },
+			length: func(info *bookscommon.Info) int { return utf8.RuneCountInString(info.Title) },
+		},
+		{
+			name:   "authors",
+			policy: config.Authors,
+			empty:  func(info *bookscommon.Info) bool { return len(info.Contributors) == 0 },
+			copy:   func(dst, src *bookscommon.Info) { dst.Contributors = src.Contributors },
+			length: func(info *bookscommon.Info) int { return len(info.Contributors) },
+		},
+		{
+			name:   "description",
+			policy: config.Description,
+			empty: func(info *bookscommon.Info) bool {
+				return info.Description == "" || info.Description == bookscommon.NoDescription
+			},
+			copy:   func(dst, src *bookscommon.Info) { dst.Description = src.Description },
+			length: func(info *bookscommon.Info) int { return utf8.RuneCountInString(info.Description) },
+		},
+		{
+			name:   "publishdate",
+			policy: config.Publishdate,
+			empty:  func(info *bookscommon.Info) bool { return info.Publishdate.IsZero() },
+			copy:   func(dst, src *bookscommon.Info) { dst.Publishdate = src.Publishdate },
+			date:   func(info *bookscommon.Info) bookscommon.Date { return info.Publishdate },
+		},
+		{
+			name:   "language",
+			policy: config.Language,
+			empty:  func(info *bookscommon.Info) bool { return len(info.Languages) == 0 },
+			copy:   func(dst, src *bookscommon.Info) { dst.Languages = src.Languages },
+		},
+		{
+			name:   "image",
+			policy: config.Image,
+			empty:  func(info *bookscommon.Info) bool { return info.Image.Source.String() == "" },
+			copy:   func(dst, src *bookscommon.Info) { dst.Image = src.Image },
+		},
+		{
+			name:   "publisher",
+			policy: config.Publisher,
+			empty:  func(info *bookscommon.Info) bool { return info.Publisher == "" },
+			copy:   func(dst, src *bookscommon.Info) { dst.Publisher = src.Publisher },
+			length: func(info *bookscommon.Info) int { return utf8.RuneCountInString(info.Publisher) },
+		},
+		{
+			name:   "pages",
+			policy: config.Pages,
+			empty:  func(info *bookscommon.Info) bool { return info.Pages == 0 },
+			copy:   func(dst, src *bookscommon.Info) { dst.Pages = src.Pages },
+			length: func(info *bookscommon.Info) int { return info.Pages },
+		},
+		{
+			name:   "subjects",
+			policy: config.Subjects,
+			empty:  func(info *bookscommon.Info) bool { return len(info.Subjects) == 0 },
+			copy:   func(dst, src *bookscommon.Info) { dst.Subjects = src.Subjects },
+			length: func(info *bookscommon.Info) int { return len(info.Subjects) },
+		},
+		{
+			name:   "edition",
+			policy: config.Edition,
+			empty:  func(info *bookscommon.Info) bool { return info.Edition == "" },
+			copy:   func(dst, src *bookscommon.Info) { dst.Edition = src.Edition },
+			length: func(info *bookscommon.Info) int { return utf8.RuneCountInString(info.Edition) },
+		},
+		{
+			name:   "series",
+			policy: config.Series,
+			empty:  func(info *bookscommon.Info) bool { return info.Series == "" },
+			copy:   func(dst, src *bookscommon.Info) { dst.Series = src.Series },
+			length: func(info *bookscommon.Info) int { return utf8.RuneCountInString(info.Series) },
+		},
+		{
+			name:   "price",
+			policy: config.Price,
+			empty:  func(info *bookscommon.Info) bool { return info.Price.Amount == 0 },
+			copy:   func(dst, src *bookscommon.Info) { dst.Price = src.Price },
+		},
+		{
+			name:   "ndc",
+			policy: config.NDC,
+			empty:  func(info *bookscommon.Info) bool { return info.NDC == "" },
+			copy:   func(dst, src *bookscommon.Info) { dst.NDC = src.NDC },
+			// 桁が多いほど細かく分類されている
+			length: func(info *bookscommon.Info) int { return len(info.NDC) },
+		},
+	}
+
+	for _, f := range fields {
+		if err := f.validate(); err != nil {
+			return nil, fmt.Errorf("invalid merge policy for %s: %w", f.name, err)
+		}
+	}
+
+	return &Merger{
+		fields: fields,
+	}, nil
+}
+
+func (f field) validate() error {
+	for _, kind := range f.policy.Priority {
+		if !kind.IsABooksComponent() {
+			return fmt.Errorf("unknown component: %s", kind)
+		}
+	}
+	for _, strategy := range []booksconfig.MergeStrategy{f.policy.Strategy, f.policy.Fallback} {
+		switch strategy {
+		case booksconfig.First, booksconfig.None:
+		case booksconfig.Longest:
+			if f.length == nil {
+				return fmt.Errorf("strategy %s is not supported", strategy)
+			}
+		case booksconfig.Newest:
+			if f.date == nil {
+				return fmt.Errorf("strategy %s is not supported", strategy)
+			}
+		default:
+			return fmt.Errorf("unknown strategy: %s", strategy)
+		}
+	}
+	return nil
+}
+
+// Merge は results を設定されたポリシーに従って一つの Info にまとめる
+// results はプロバイダーの設定順に並んでいる必要がある
+// isbn が空の場合は results の ISBN を使う
+func (m *Merger) Merge(isbn string, results []Result) (*bookscommon.Info, error) {
+	if len(results) == 0 {
+		return nil, bookscommon.ErrNotFoundBook
+	}
+
+	info := &bookscommon.Info{
+		ISBN: isbn,
+	}
+	for _, f := range m.fields {
+		preferred, rest := f.split(results)
+		src := f.pick(preferred, f.policy.Strategy)
+		if src == nil {
+			src = f.pick(rest, f.policy.Fallback)
+		}
+		if src != nil {
+			f.copy(info, src)
+		}
+	}
+
+	if info.Description == "" {
+		info.Description = bookscommon.NoDescription
+	}
+	// どのプロバイダーも言語を返さなかった場合はタイトルの文字から推測する
+	if len(info.Languages) == 0 {
+		if l := bookscommon.DetectLanguage(info.Title); l != "" {
+			info.Languages = []bookscommon.Language{l}
+		}
+	}
+
+	// 識別子は全てのプロバイダーのものをまとめる
+	seen := make(map[bookscommon.Identifier]bool)
+	for _, result := range results {
+		if result.Info == nil {
+			continue
+		}
+		if info.ISBN == "" {
+			info.ISBN = result.Info.ISBN
+		}
+		for _, id := range result.Info.Identifiers {
+			if !seen[id] {
+				seen[id] = true
+				info.Identifiers = append(info.Identifiers, id)
+			}
+		}
+	}
+	if isbn := (bookscommon.Identifier{Type: bookscommon.ISBN, Value: info.ISBN}); info.ISBN != "" && !seen[isbn] {
+		info.Identifiers = append([]bookscommon.Identifier{isbn}, info.Identifiers...)
+	}
+
+	// 表紙の候補も全てのプロバイダーのものをまとめる
+	covers := make(map[string]bool)
+	for _, result := range results {
+		if result.Info == nil {
+			continue
+		}
+		for _, cover := range result.Info.Covers {
+			if u := cover.URL.String(); !covers[u] {
+				covers[u] = true
+				info.Covers = append(info.Covers, cover)
+			}
+		}
+	}
+	return info, nil
+}
+
+// split は results を Priority に含まれるもの (Priority の順) とそれ以外に分ける
+func (f field) split(results []Result) ([]*bookscommon.Info, []*bookscommon.Info) {
+	var preferred, rest []*bookscommon.Info
+	if len(f.policy.Priority) == 0 {
+		for _, result := range results {
+			preferred = append(preferred, result.Info)
+		}
+		return preferred, nil
+	}
+
+	for _, kind := range f.policy.Priority {
+		for _, result := range results {
+			if result.Kind == kind {
+				preferred = append(preferred, result.Info)
+			}
+		}
+	}
+	for _, result := range results {
+		if !slices.Contains(f.policy.Priority, result.Kind) {
+			rest = append(rest, result.Info)
+		}
+	}
+	return preferred, rest
+}
+
+func (f field) pick(infos []*bookscommon.Info, strategy booksconfig.MergeStrategy) *bookscommon.Info {
+	var picked *bookscommon.Info
+	for _, info := range infos {
+		if info == nil || f.empty(info) {
+			continue
+		}
+		switch strategy {
+		case booksconfig.First:
+			return info
+		case booksconfig.Longest:
+			if picked == nil || f.length(info) > f.length(picked) {
+				picked = info
+			}
+		case booksconfig.Newest:
+			if picked == nil || f.date(info).After(f.date(picked)) {
+				picked = info
+			}
+		case booksconfig.None:
+			return nil
+		}
+	}
+	return picked
+}
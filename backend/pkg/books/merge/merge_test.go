@@ -0,0 +1,219 @@
+package booksmerge
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+)
+
+func date(year int, month time.Month, day int) bookscommon.Date {
+	return bookscommon.NewDate(time.Date(year, month, day, 0, 0, 0, 0, time.UTC), bookscommon.DateDay)
+}
+
+// testResults は Google, NDL, OpenBD の順に並んだ結果
+func testResults() []Result {
+	return []Result{
+		{Kind: booksconfig.Google, Info: &bookscommon.Info{
+			ISBN:        "9784101001565",
+			Title:       "こころ",
+			Description: "短い説明",
+			Publishdate: date(2004, time.March, 1),
+			Pages:       300,
+		}},
+		{Kind: booksconfig.NDL, Info: &bookscommon.Info{
+			Title:       "こころ (新潮文庫)",
+			Publishdate: date(1952, time.February, 1),
+			Publisher:   "新潮社",
+			NDC:         "913.6",
+		}},
+		{Kind: booksconfig.OpenBD, Info: &bookscommon.Info{
+			Title:       "こころ 改版",
+			Description: "先生と私の物語を描いた長い説明",
+			Publishdate: date(2012, time.June, 15),
+			Publisher:   "新潮社 (openBD)",
+			NDC:         "913",
+		}},
+	}
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name   string
+		config booksconfig.MergeConfig
+		check  func(t *testing.T, info *bookscommon.Info)
+	}{
+		{
+			name: "first uses provider order",
+			check: func(t *testing.T, info *bookscommon.Info) {
+				if info.Title != "こころ" {
+					t.Errorf("Title = %q, want %q", info.Title, "こころ")
+				}
+				// Google は出版社を返さないので次の NDL を使う
+				if info.Publisher != "新潮社" {
+					t.Errorf("Publisher = %q, want %q", info.Publisher, "新潮社")
+				}
+			},
+		},
+		{
+			name: "longest",
+			config: booksconfig.MergeConfig{
+				Title:       booksconfig.FieldPolicy{Strategy: booksconfig.Longest},
+				Description: booksconfig.FieldPolicy{Strategy: booksconfig.Longest},
+				NDC:         booksconfig.FieldPolicy{Strategy: booksconfig.Longest},
+			},
+			check: func(t *testing.T, info *bookscommon.Info) {
+				if info.Title != "こころ (新潮文庫)" {
+					t.Errorf("Title = %q, want %q", info.Title, "こころ (新潮文庫)")
+				}
+				if info.Description != "先生と私の物語を描いた長い説明" {
+					t.Errorf("Description = %q", info.Description)
+				}
+				if info.NDC != "913.6" {
+					t.Errorf("NDC = %q, want %q", info.NDC, "913.6")
+				}
+			},
+		},
+		{
+			name: "newest",
+			config: booksconfig.MergeConfig{
+				Publishdate: booksconfig.FieldPolicy{Strategy: booksconfig.Newest},
+			},
+			check: func(t *testing.T, info *bookscommon.Info) {
+				if want := date(2012, time.June, 15); info.Publishdate != want {
+					t.Errorf("Publishdate = %v, want %v", info.Publishdate, want)
+				}
+			},
+		},
+		{
+			name: "none leaves field empty",
+			config: booksconfig.MergeConfig{
+				Pages:       booksconfig.FieldPolicy{Strategy: booksconfig.None},
+				Description: booksconfig.FieldPolicy{Strategy: booksconfig.None},
+			},
+			check: func(t *testing.T, info *bookscommon.Info) {
+				if info.Pages != 0 {
+					t.Errorf("Pages = %d, want 0", info.Pages)
+				}
+				if info.Description != bookscommon.NoDescription {
+					t.Errorf("Description = %q, want %q", info.Description, bookscommon.NoDescription)
+				}
+			},
+		},
+		{
+			name: "priority order",
+			config: booksconfig.MergeConfig{
+				Title:     booksconfig.FieldPolicy{Priority: []booksconfig.BooksComponent{booksconfig.OpenBD, booksconfig.NDL}},
+				Publisher: booksconfig.FieldPolicy{Priority: []booksconfig.BooksComponent{booksconfig.OpenBD}},
+			},
+			check: func(t *testing.T, info *bookscommon.Info) {
+				if info.Title != "こころ 改版" {
+					t.Errorf("Title = %q, want %q", info.Title, "こころ 改版")
+				}
+				if info.Publisher != "新潮社 (openBD)" {
+					t.Errorf("Publisher = %q, want %q", info.Publisher, "新潮社 (openBD)")
+				}
+			},
+		},
+		{
+			name: "fallback to remaining providers",
+			config: booksconfig.MergeConfig{
+				// OpenLibrary の結果は無いので残りから Longest で選ぶ
+				Title: booksconfig.FieldPolicy{
+					Priority: []booksconfig.BooksComponent{booksconfig.OpenLibrary},
+					Fallback: booksconfig.Longest,
+				},
+				// Google は出版社を返さず、Fallback が None なので空のまま
+				Publisher: booksconfig.FieldPolicy{
+					Priority: []booksconfig.BooksComponent{booksconfig.Google},
+					Fallback: booksconfig.None,
+				},
+			},
+			check: func(t *testing.T, info *bookscommon.Info) {
+				if info.Title != "こころ (新潮文庫)" {
+					t.Errorf("Title = %q, want %q", info.Title, "こころ (新潮文庫)")
+				}
+				if info.Publisher != "" {
+					t.Errorf("Publisher = %q, want empty", info.Publisher)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merger, err := NewMerger(tt.config)
+			if err != nil {
+				t.Fatalf("NewMerger() error = %v", err)
+			}
+			info, err := merger.Merge("", testResults())
+			if err != nil {
+				t.Fatalf("Merge() error = %v", err)
+			}
+			if info.ISBN != "9784101001565" {
+				t.Errorf("ISBN = %q, want %q", info.ISBN, "9784101001565")
+			}
+			tt.check(t, info)
+		})
+	}
+}
+
+func TestMergeNoResults(t *testing.T) {
+	merger, err := NewMerger(booksconfig.MergeConfig{})
+	if err != nil {
+		t.Fatalf("NewMerger() error = %v", err)
+	}
+	if _, err := merger.Merge("9784101001565", nil); !errors.Is(err, bookscommon.ErrNotFoundBook) {
+		t.Errorf("Merge() error = %v, want %v", err, bookscommon.ErrNotFoundBook)
+	}
+}
+
+func TestNewMergerValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  booksconfig.MergeConfig
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			config: booksconfig.MergeConfig{
+				Title:       booksconfig.FieldPolicy{Priority: []booksconfig.BooksComponent{booksconfig.NDL}, Strategy: booksconfig.Longest, Fallback: booksconfig.First},
+				Publishdate: booksconfig.FieldPolicy{Strategy: booksconfig.Newest},
+			},
+		},
+		{
+			name:    "unknown provider",
+			config:  booksconfig.MergeConfig{Title: booksconfig.FieldPolicy{Priority: []booksconfig.BooksComponent{booksconfig.BooksComponent(99)}}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown strategy",
+			config:  booksconfig.MergeConfig{Title: booksconfig.FieldPolicy{Strategy: booksconfig.MergeStrategy(99)}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown fallback",
+			config:  booksconfig.MergeConfig{Title: booksconfig.FieldPolicy{Fallback: booksconfig.MergeStrategy(99)}},
+			wantErr: true,
+		},
+		{
+			name:    "longest on field without length",
+			config:  booksconfig.MergeConfig{Image: booksconfig.FieldPolicy{Strategy: booksconfig.Longest}},
+			wantErr: true,
+		},
+		{
+			name:    "newest on field without date",
+			config:  booksconfig.MergeConfig{Title: booksconfig.FieldPolicy{Strategy: booksconfig.Newest}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMerger(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewMerger() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -5,56 +5,237 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
-	"time"
 
 	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+	booksndc "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/ndc"
 )
 
-// RSS構造体 (必要最小限)
-type RSS struct {
-	XMLName xml.Name `xml:"rss"`
-	Channel struct {
-		Items []Item `xml:"item"`
-	} `xml:"channel"`
+const defaultBaseURL = "https://ndlsearch.ndl.go.jp"
+
+// rdf:datatype の dcndl 名前空間
+const nsDCNDL = "http://ndl.go.jp/dcndl/terms/"
+
+// SRU構造体 (recordSchema=dcndl, 必要最小限)
+type SRU struct {
+	XMLName xml.Name `xml:"searchRetrieveResponse"`
+	Records []struct {
+		Resources []BibResource `xml:"recordData>RDF>BibResource"`
+	} `xml:"records>record"`
+}
+
+// BibResource は DC-NDL の書誌一件分
+type BibResource struct {
+	Identifiers []Typed       `xml:"http://purl.org/dc/terms/ identifier"`
+	Title       string        `xml:"http://purl.org/dc/terms/ title"`
+	Volume      []Description `xml:"http://ndl.go.jp/dcndl/terms/ volume"`
+	Edition     string        `xml:"http://ndl.go.jp/dcndl/terms/ edition"`
+	SeriesTitle []Description `xml:"http://ndl.go.jp/dcndl/terms/ seriesTitle"`
+	// dc:creator は "山田太郎 著" のような責任表示
+	Creators   []string  `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	Publishers []Agent   `xml:"http://purl.org/dc/terms/ publisher"`
+	Issued     string    `xml:"http://purl.org/dc/terms/ issued"`
+	Date       string    `xml:"http://purl.org/dc/terms/ date"`
+	Price      string    `xml:"http://ndl.go.jp/dcndl/terms/ price"`
+	Extent     []string  `xml:"http://purl.org/dc/terms/ extent"`
+	Subjects   []Subject `xml:"http://purl.org/dc/terms/ subject"`
+	DCSubjects []Typed   `xml:"http://purl.org/dc/elements/1.1/ subject"`
+	Languages  []string  `xml:"http://purl.org/dc/terms/ language"`
+}
+
+// Typed は rdf:datatype で種類を表す値
+type Typed struct {
+	Datatype string `xml:"http://www.w3.org/1999/02/22-rdf-syntax-ns# datatype,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// Description は rdf:Description に包まれた値
+type Description struct {
+	Value string `xml:"Description>value"`
+}
+
+type Agent struct {
+	Name string `xml:"Agent>name"`
+}
+
+// Subject は dcterms:subject で、件名は rdf:Description、分類は rdf:resource で表される
+type Subject struct {
+	Resource string `xml:"http://www.w3.org/1999/02/22-rdf-syntax-ns# resource,attr"`
+	Value    string `xml:"Description>value"`
+}
+
+// ISBN は dcterms:identifier から ISBN を取り出す
+func (r BibResource) ISBN() string {
+	for _, id := range r.Identifiers {
+		if strings.TrimPrefix(id.Datatype, nsDCNDL) != "ISBN" {
+			continue
+		}
+		if isbn := bookscommon.NormalizeISBN(id.Value); isbn != "" {
+			return isbn
+		}
+	}
+	return ""
+}
+
+// 識別子の rdf:datatype と種類の対応
+var identifierTypes = map[string]bookscommon.IdentifierType{
+	"JPNO":   bookscommon.JPNO,
+	"NCID":   bookscommon.NCID,
+	"ISSN":   bookscommon.ISSN,
+	"OCLCNO": bookscommon.OCLC,
+}
+
+// IdentifierList は ISBN と対応している識別子を返す
+func (r BibResource) IdentifierList() []bookscommon.Identifier {
+	var identifiers []bookscommon.Identifier
+	if isbn := r.ISBN(); isbn != "" {
+		identifiers = append(identifiers, bookscommon.Identifier{Type: bookscommon.ISBN, Value: isbn})
+	}
+	for _, id := range r.Identifiers {
+		if t, ok := identifierTypes[strings.TrimPrefix(id.Datatype, nsDCNDL)]; ok {
+			identifiers = append(identifiers, bookscommon.NormalizeIdentifier(bookscommon.Identifier{Type: t, Value: id.Value}))
+		}
+	}
+	return identifiers
+}
+
+// ParseCreators は "山田太郎 著" のような dc:creator を役割付きで分解する
+func ParseCreators(creators []string) []bookscommon.Contributor {
+	var contributors []bookscommon.Contributor
+	for _, creator := range creators {
+		contributors = append(contributors, bookscommon.ParseContributors(creator)...)
+	}
+	return contributors
 }
 
-type Item struct {
-	Title    string   `xml:"title"`
-	Authors  []string `xml:"creator"`
-	PubDate  string   `xml:"pubDate"`
-	Language string   `xml:"publicationPlace"`
-	Volume   string   `xml:"volume"`
+// NDC の版は新しいものを優先する
+var ndcTypes = []string{"ndc10", "ndc9", "ndc8", "ndc"}
+
+// NDC は dcterms:subject の rdf:resource か dc:subject から日本十進分類法の分類記号を取り出す
+func (r BibResource) NDC() string {
+	for _, t := range ndcTypes {
+		for _, subject := range r.Subjects {
+			if code, ok := strings.CutPrefix(subject.Resource, "http://id.ndl.go.jp/class/"+t+"/"); ok {
+				if ndc := booksndc.Normalize(code); ndc != "" {
+					return ndc
+				}
+			}
+		}
+		for _, subject := range r.DCSubjects {
+			if strings.EqualFold(strings.TrimPrefix(subject.Datatype, nsDCNDL), t) {
+				if ndc := booksndc.Normalize(subject.Value); ndc != "" {
+					return ndc
+				}
+			}
+		}
+	}
+	return ""
 }
 
-func StringToDate(s string) (time.Time, error) {
-	if t, err := time.Parse(time.RFC1123Z, s); err == nil {
-		return t, nil
+// SubjectHeadings は分類記号を除いた件名を取り出す
+func (r BibResource) SubjectHeadings() []string {
+	var headings []string
+	for _, subject := range r.Subjects {
+		if value := strings.TrimSpace(subject.Value); value != "" {
+			headings = append(headings, value)
+		}
+	}
+	return headings
+}
+
+var (
+	pagesPattern = regexp.MustCompile(`(\d+)\s*[pP枚]`)
+	pricePattern = regexp.MustCompile(`[\d,]+`)
+)
+
+// ExtentToPages は "237p ; 19cm" のような dcterms:extent からページ数を取り出す
+func ExtentToPages(s string) int {
+	m := pagesPattern.FindStringSubmatch(s)
+	if m == nil {
+		return 0
 	}
-	if t, err := time.Parse(time.RFC1123, s); err == nil {
-		return t, nil
+	pages, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0
 	}
-	return time.Time{}, fmt.Errorf("failed to convert date: %s", s)
+	return pages
 }
 
-// ItemからBookInfoへ変換
-func ToBookInfo(item Item) bookscommon.Info {
-	date, err := StringToDate(item.PubDate)
+// StringToPrice は "1800円" や "1,800円 (税別)" のような dcndl:price を変換する
+func StringToPrice(s string) bookscommon.Price {
+	if !strings.Contains(s, "円") {
+		return bookscommon.Price{}
+	}
+	amount, err := strconv.ParseFloat(strings.ReplaceAll(pricePattern.FindString(s), ",", ""), 64)
 	if err != nil {
-		date = time.Time{}
+		return bookscommon.Price{}
 	}
+	return bookscommon.Price{Amount: amount, Currency: "JPY"}
+}
 
-	return bookscommon.Info{
-		Title:       item.Title,
-		Authors:     item.Authors,
-		Publishdate: date,
+// ToBookInfo は DC-NDL の書誌を Info に変換する
+func ToBookInfo(r BibResource) bookscommon.Info {
+	info := bookscommon.Info{
+		ISBN:         r.ISBN(),
+		Identifiers:  r.IdentifierList(),
+		Title:        strings.TrimSpace(r.Title),
+		Contributors: ParseCreators(r.Creators),
+		Description:  bookscommon.NoDescription,
+		Subjects:     r.SubjectHeadings(),
+		NDC:          r.NDC(),
+		Edition:      strings.TrimSpace(r.Edition),
+		Price:        StringToPrice(r.Price),
+	}
+	if len(r.Volume) > 0 && r.Volume[0].Value != "" {
+		info.Title = info.Title + " " + strings.TrimSpace(r.Volume[0].Value)
 	}
+	if len(r.SeriesTitle) > 0 {
+		info.Series = strings.TrimSpace(r.SeriesTitle[0].Value)
+	}
+	if len(r.Publishers) > 0 {
+		info.Publisher = strings.TrimSpace(r.Publishers[0].Name)
+	}
+	for _, extent := range r.Extent {
+		if pages := ExtentToPages(extent); pages > 0 {
+			info.Pages = pages
+			break
+		}
+	}
+	info.Languages = bookscommon.ParseLanguages(r.Languages)
+
+	// dcterms:issued (W3CDTF) が無い場合は dcterms:date ("2005.3" など) を使う
+	if date, err := dcDateToDate(r.Issued); err == nil {
+		info.Publishdate = date
+	} else if date, err := dcDateToDate(r.Date); err == nil {
+		info.Publishdate = date
+	}
+	return info
 }
 
-type NDL struct{}
+type NDL struct {
+	client  *http.Client
+	baseURL string
+}
 
-func NewNDL() (*NDL, error) {
-	return &NDL{}, nil
+func NewNDL(config booksconfig.NDLBooksConfig, client *http.Client) (*NDL, error) {
+	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+	if _, err := url.Parse(baseURL); err != nil {
+		return nil, fmt.Errorf("failed to parse url: %w", err)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &NDL{
+		client:  client,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}, nil
 }
 
 func (s *NDL) Close() error {
@@ -62,50 +243,32 @@ func (s *NDL) Close() error {
 }
 
 func (s *NDL) GetInfo(isbn string) (*bookscommon.Info, error) {
-	u := fmt.Sprintf("https://ndlsearch.ndl.go.jp/api/opensearch?isbn=%s", isbn)
-	resp, err := http.Get(u)
+	resources, err := s.sru(fmt.Sprintf(`isbn="%s"`, cqlEscape(isbn)), 10)
 	if err != nil {
-		return nil, fmt.Errorf("failed to request: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
-
-	var rss RSS
-	if err := xml.NewDecoder(resp.Body).Decode(&rss); err != nil {
-		return nil, fmt.Errorf("failed to decode XML: %w", err)
-	}
-
-	if len(rss.Channel.Items) == 0 {
-		return nil, fmt.Errorf("no book found for ISBN: %s", isbn)
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("%w: %s", bookscommon.ErrNotFoundBook, isbn)
 	}
 
-	item := rss.Channel.Items[0]
-	info := ToBookInfo(item)
-	volume := item.Volume
-	for _, item := range rss.Channel.Items {
-		titles := append(strings.Fields(item.Title), strings.Fields(info.Title)...)
-		seen := make(map[string]bool)
-		merged := []string{}
-		for _, title := range titles {
-			if !seen[title] {
-				seen[title] = true
-				merged = append(merged, title)
+	info := ToBookInfo(resources[0])
+	// 巻次は別のレコードにしか無いことがある
+	if len(resources[0].Volume) == 0 {
+		for _, r := range resources[1:] {
+			if len(r.Volume) > 0 && r.Volume[0].Value != "" {
+				info.Title = info.Title + " " + strings.TrimSpace(r.Volume[0].Value)
+				break
 			}
 		}
-		info.Title = strings.Join(merged, " ")
-
-		if volume == "" {
-			volume = item.Volume
-		}
 	}
-	info.Title = info.Title + " " + volume
-
 	info.ISBN = isbn
-	info.Language = bookscommon.JP
-	info.Description = bookscommon.NoDescription
+	if !slices.Contains(info.Identifiers, bookscommon.Identifier{Type: bookscommon.ISBN, Value: isbn}) {
+		info.Identifiers = append([]bookscommon.Identifier{{Type: bookscommon.ISBN, Value: isbn}}, info.Identifiers...)
+	}
 
-	imgUrl, err := url.Parse("https://ndlsearch.ndl.go.jp/thumbnail/" + isbn + ".jpg")
+	imgUrl, err := url.Parse(s.baseURL + "/thumbnail/" + url.PathEscape(isbn) + ".jpg")
 	if err == nil {
-		imgResp, err := http.Head(imgUrl.String())
+		imgResp, err := s.client.Head(imgUrl.String())
 		if err != nil {
 			return &info, nil
 		}
@@ -117,3 +280,98 @@ func (s *NDL) GetInfo(isbn string) (*bookscommon.Info, error) {
 
 	return &info, nil
 }
+
+const searchMaxResults = 20
+
+func (s *NDL) Search(query bookscommon.Query) ([]bookscommon.Info, error) {
+	var clauses []string
+	if query.Title != "" {
+		clauses = append(clauses, fmt.Sprintf(`title="%s"`, cqlEscape(query.Title)))
+	}
+	if query.Author != "" {
+		clauses = append(clauses, fmt.Sprintf(`creator="%s"`, cqlEscape(query.Author)))
+	}
+	if len(clauses) == 0 {
+		return nil, fmt.Errorf("query is empty")
+	}
+
+	resources, err := s.sru(strings.Join(clauses, " AND "), searchMaxResults)
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []bookscommon.Info
+	for _, r := range resources {
+		info := ToBookInfo(r)
+		if info.ISBN == "" {
+			continue
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// GetInfoByIdentifier は JP番号 (全国書誌番号) で本を探す
+func (s *NDL) GetInfoByIdentifier(id bookscommon.Identifier) (*bookscommon.Info, error) {
+	if id.Type != bookscommon.JPNO {
+		return nil, bookscommon.ErrNotSupported
+	}
+
+	resources, err := s.sru(fmt.Sprintf(`jpno="%s"`, cqlEscape(id.Value)), 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("%w: %s", bookscommon.ErrNotFoundBook, id.Value)
+	}
+
+	info := ToBookInfo(resources[0])
+	if !slices.Contains(info.Identifiers, id) {
+		info.Identifiers = append([]bookscommon.Identifier{id}, info.Identifiers...)
+	}
+	return &info, nil
+}
+
+// sru は SRU で CQL の query を検索し、各レコードの書誌を返す
+func (s *NDL) sru(query string, maximumRecords int) ([]BibResource, error) {
+	params := url.Values{
+		"operation":      {"searchRetrieve"},
+		"version":        {"1.2"},
+		"recordSchema":   {"dcndl"},
+		"recordPacking":  {"xml"},
+		"maximumRecords": {strconv.Itoa(maximumRecords)},
+		"query":          {query},
+	}
+	resp, err := s.client.Get(s.baseURL + "/api/sru?" + params.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("failed to request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad status: %s", resp.Status)
+	}
+
+	var sru SRU
+	if err := xml.NewDecoder(resp.Body).Decode(&sru); err != nil {
+		return nil, fmt.Errorf("failed to decode XML: %w", err)
+	}
+
+	var resources []BibResource
+	for _, record := range sru.Records {
+		// 一つのレコードに複数の BibResource がある場合は最初のものが本体
+		if len(record.Resources) > 0 {
+			resources = append(resources, record.Resources[0])
+		}
+	}
+	return resources, nil
+}
+
+func cqlEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
+
+// dcDateToDate は "2005-03" のような W3CDTF や "2005.3" のような dcterms:date を変換する
+func dcDateToDate(s string) (bookscommon.Date, error) {
+	return bookscommon.ParseDate(s, "2006-01-02", "2006-01", "2006", "2006.1.2", "2006.1")
+}
@@ -0,0 +1,170 @@
+package ndlbooks
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+	booksfixture "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/fixture"
+)
+
+func newTestNDL(t *testing.T) *NDL {
+	t.Helper()
+	transport, err := booksfixture.NewTransport(booksconfig.FixtureConfig{Mode: booksconfig.Replay, Dir: "testdata"}, nil)
+	if err != nil {
+		t.Fatalf("NewTransport() error = %v", err)
+	}
+	books, err := NewNDL(booksconfig.NDLBooksConfig{}, &http.Client{Transport: transport})
+	if err != nil {
+		t.Fatalf("NewNDL() error = %v", err)
+	}
+	return books
+}
+
+func TestGetInfo(t *testing.T) {
+	info, err := newTestNDL(t).GetInfo("9784101010137")
+	if err != nil {
+		t.Fatalf("GetInfo() error = %v", err)
+	}
+
+	if info.Title != "こころ" {
+		t.Errorf("Title = %q, want %q", info.Title, "こころ")
+	}
+	wantIdentifiers := []bookscommon.Identifier{
+		{Type: bookscommon.ISBN, Value: "9784101010137"},
+		{Type: bookscommon.JPNO, Value: "20563321"},
+		{Type: bookscommon.NCID, Value: "BA66564521"},
+	}
+	if !reflect.DeepEqual(info.Identifiers, wantIdentifiers) {
+		t.Errorf("Identifiers = %+v, want %+v", info.Identifiers, wantIdentifiers)
+	}
+	wantContributors := []bookscommon.Contributor{{Name: "夏目漱石", Role: bookscommon.Author}}
+	if !reflect.DeepEqual(info.Contributors, wantContributors) {
+		t.Errorf("Contributors = %+v, want %+v", info.Contributors, wantContributors)
+	}
+	if info.Publisher != "新潮社" {
+		t.Errorf("Publisher = %q, want %q", info.Publisher, "新潮社")
+	}
+	if info.Edition != "改版" {
+		t.Errorf("Edition = %q, want %q", info.Edition, "改版")
+	}
+	if info.Series != "新潮文庫" {
+		t.Errorf("Series = %q, want %q", info.Series, "新潮文庫")
+	}
+	if want := (bookscommon.Price{Amount: 400, Currency: "JPY"}); info.Price != want {
+		t.Errorf("Price = %+v, want %+v", info.Price, want)
+	}
+	if info.Pages != 326 {
+		t.Errorf("Pages = %d, want 326", info.Pages)
+	}
+	// ndlc の分類記号は NDC として扱わない
+	if info.NDC != "913.6" {
+		t.Errorf("NDC = %q, want %q", info.NDC, "913.6")
+	}
+	if want := []string{"小説 (日本)"}; !reflect.DeepEqual(info.Subjects, want) {
+		t.Errorf("Subjects = %v, want %v", info.Subjects, want)
+	}
+	if want := []bookscommon.Language{"ja"}; !reflect.DeepEqual(info.Languages, want) {
+		t.Errorf("Languages = %v, want %v", info.Languages, want)
+	}
+	if want := bookscommon.NewDate(time.Date(2004, time.March, 1, 0, 0, 0, 0, time.UTC), bookscommon.DateMonth); info.Publishdate != want {
+		t.Errorf("Publishdate = %v, want %v", info.Publishdate, want)
+	}
+	if want := "https://ndlsearch.ndl.go.jp/thumbnail/9784101010137.jpg"; info.Image.Source.String() != want {
+		t.Errorf("Image.Source = %q, want %q", info.Image.Source.String(), want)
+	}
+}
+
+func TestGetInfoNotFound(t *testing.T) {
+	_, err := newTestNDL(t).GetInfo("9780000000002")
+	if !errors.Is(err, bookscommon.ErrNotFoundBook) {
+		t.Errorf("GetInfo() error = %v, want %v", err, bookscommon.ErrNotFoundBook)
+	}
+}
+
+func TestGetInfoByIdentifier(t *testing.T) {
+	books := newTestNDL(t)
+
+	info, err := books.GetInfoByIdentifier(bookscommon.Identifier{Type: bookscommon.JPNO, Value: "20563321"})
+	if err != nil {
+		t.Fatalf("GetInfoByIdentifier() error = %v", err)
+	}
+	if info.ISBN != "9784101010137" {
+		t.Errorf("ISBN = %q, want %q", info.ISBN, "9784101010137")
+	}
+
+	if _, err := books.GetInfoByIdentifier(bookscommon.Identifier{Type: bookscommon.ASIN, Value: "B000000000"}); !errors.Is(err, bookscommon.ErrNotSupported) {
+		t.Errorf("GetInfoByIdentifier() error = %v, want %v", err, bookscommon.ErrNotSupported)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	infos, err := newTestNDL(t).Search(bookscommon.Query{Title: "こころ", Author: "夏目漱石"})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	// ISBN の無いレコードは除く
+	if len(infos) != 1 {
+		t.Fatalf("len(Search()) = %d, want 1", len(infos))
+	}
+	info := infos[0]
+	// ISBN-10 は ISBN-13 に揃える
+	if info.ISBN != "9784101010137" {
+		t.Errorf("ISBN = %q, want %q", info.ISBN, "9784101010137")
+	}
+	if info.Title != "こころ 上" {
+		t.Errorf("Title = %q, want %q", info.Title, "こころ 上")
+	}
+	wantContributors := []bookscommon.Contributor{
+		{Name: "夏目漱石", Role: bookscommon.Author},
+		{Name: "三好行雄", Role: bookscommon.Editor},
+	}
+	if !reflect.DeepEqual(info.Contributors, wantContributors) {
+		t.Errorf("Contributors = %+v, want %+v", info.Contributors, wantContributors)
+	}
+	if info.Pages != 237 {
+		t.Errorf("Pages = %d, want 237", info.Pages)
+	}
+	if want := bookscommon.NewDate(time.Date(1952, time.February, 1, 0, 0, 0, 0, time.UTC), bookscommon.DateMonth); info.Publishdate != want {
+		t.Errorf("Publishdate = %v, want %v", info.Publishdate, want)
+	}
+}
+
+func TestExtentToPages(t *testing.T) {
+	tests := []struct {
+		extent string
+		want   int
+	}{
+		{"237p ; 19cm", 237},
+		{"xii, 345 p", 345},
+		{"1冊", 0},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		if got := ExtentToPages(tt.extent); got != tt.want {
+			t.Errorf("ExtentToPages(%q) = %d, want %d", tt.extent, got, tt.want)
+		}
+	}
+}
+
+func TestStringToPrice(t *testing.T) {
+	tests := []struct {
+		price string
+		want  bookscommon.Price
+	}{
+		{"1800円", bookscommon.Price{Amount: 1800, Currency: "JPY"}},
+		{"1,800円 (税別)", bookscommon.Price{Amount: 1800, Currency: "JPY"}},
+		{"非売品", bookscommon.Price{}},
+		{"$12.00", bookscommon.Price{}},
+	}
+	for _, tt := range tests {
+		if got := StringToPrice(tt.price); got != tt.want {
+			t.Errorf("StringToPrice(%q) = %+v, want %+v", tt.price, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,386 @@
+package openbdbooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+)
+
+const defaultBaseURL = "https://api.openbd.jp"
+
+// ONIX のコードのうち使うもの
+const (
+	textTypeShortDescription = "02"
+	textTypeLongDescription  = "03"
+
+	resourceContentTypeFrontCover = "01"
+
+	publishingDateRolePublication = "01"
+
+	extentTypeMainContentPageCount = "11"
+	extentUnitPages                = "03"
+
+	contributorRoleAuthor         = "A01"
+	contributorRoleIllustrator    = "A12"
+	contributorRoleOriginalAuthor = "A38"
+	contributorRoleEditor         = "B01"
+	contributorRoleTranslator     = "B06"
+
+	languageRoleText = "01"
+
+	priceTypeFixedRetail = "03"
+	priceTypeRRP         = "01"
+)
+
+// Book は openBD の get API が返す一冊分の構造体 (必要最小限)
+type Book struct {
+	Onix    Onix    `json:"onix"`
+	Summary Summary `json:"summary"`
+}
+
+type Summary struct {
+	ISBN      string `json:"isbn"`
+	Title     string `json:"title"`
+	Volume    string `json:"volume"`
+	Series    string `json:"series"`
+	Publisher string `json:"publisher"`
+	PubDate   string `json:"pubdate"`
+	Cover     string `json:"cover"`
+	Author    string `json:"author"`
+}
+
+// Content は ONIX の読み (collationkey) 付きテキスト
+type Content struct {
+	CollationKey string `json:"collationkey"`
+	Content      string `json:"content"`
+}
+
+type Onix struct {
+	DescriptiveDetail struct {
+		TitleDetail struct {
+			TitleElement struct {
+				TitleText  Content `json:"TitleText"`
+				Subtitle   Content `json:"Subtitle"`
+				PartNumber string  `json:"PartNumber"`
+			} `json:"TitleElement"`
+		} `json:"TitleDetail"`
+		Contributor []Contributor `json:"Contributor"`
+		Extent      []struct {
+			ExtentType  string `json:"ExtentType"`
+			ExtentValue string `json:"ExtentValue"`
+			ExtentUnit  string `json:"ExtentUnit"`
+		} `json:"Extent"`
+		Subject []struct {
+			SubjectSchemeIdentifier string `json:"SubjectSchemeIdentifier"`
+			SubjectCode             string `json:"SubjectCode"`
+			SubjectHeadingText      string `json:"SubjectHeadingText"`
+		} `json:"Subject"`
+		Language []struct {
+			LanguageRole string `json:"LanguageRole"`
+			LanguageCode string `json:"LanguageCode"`
+		} `json:"Language"`
+	} `json:"DescriptiveDetail"`
+	CollateralDetail struct {
+		TextContent []struct {
+			TextType string `json:"TextType"`
+			Text     string `json:"Text"`
+		} `json:"TextContent"`
+		SupportingResource []struct {
+			ResourceContentType string `json:"ResourceContentType"`
+			ResourceVersion     []struct {
+				ResourceLink string `json:"ResourceLink"`
+			} `json:"ResourceVersion"`
+		} `json:"SupportingResource"`
+	} `json:"CollateralDetail"`
+	PublishingDetail struct {
+		Imprint struct {
+			ImprintName string `json:"ImprintName"`
+		} `json:"Imprint"`
+		Publisher struct {
+			PublisherName string `json:"PublisherName"`
+		} `json:"Publisher"`
+		PublishingDate []struct {
+			PublishingDateRole string `json:"PublishingDateRole"`
+			Date               string `json:"Date"`
+		} `json:"PublishingDate"`
+	} `json:"PublishingDetail"`
+	ProductSupply struct {
+		SupplyDetail struct {
+			Price []Price `json:"Price"`
+		} `json:"SupplyDetail"`
+	} `json:"ProductSupply"`
+}
+
+type Contributor struct {
+	SequenceNumber  string   `json:"SequenceNumber"`
+	ContributorRole []string `json:"ContributorRole"`
+	PersonName      Content  `json:"PersonName"`
+}
+
+// Role は ONIX の ContributorRole を役割に変換する (分からない場合は著者)
+func (c Contributor) Role() bookscommon.ContributorRole {
+	for _, code := range c.ContributorRole {
+		switch code {
+		case contributorRoleAuthor:
+			return bookscommon.Author
+		case contributorRoleTranslator:
+			return bookscommon.Translator
+		case contributorRoleIllustrator:
+			return bookscommon.Illustrator
+		case contributorRoleEditor:
+			return bookscommon.Editor
+		case contributorRoleOriginalAuthor:
+			return bookscommon.OriginalCreator
+		}
+	}
+	return bookscommon.Author
+}
+
+type Price struct {
+	PriceType    string `json:"PriceType"`
+	PriceAmount  string `json:"PriceAmount"`
+	CurrencyCode string `json:"CurrencyCode"`
+}
+
+// Detail は ONIX と summary のどちらかから取り出した情報
+type Detail struct {
+	Title        string
+	TitleReading string
+	Contributors []bookscommon.Contributor
+	Publisher    string
+	Price        Price
+}
+
+type OpenBD struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewOpenBD(config booksconfig.OpenBDBooksConfig, client *http.Client) (*OpenBD, error) {
+	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+	if _, err := url.Parse(baseURL); err != nil {
+		return nil, fmt.Errorf("failed to parse url: %w", err)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &OpenBD{
+		client:  client,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}, nil
+}
+
+func (s *OpenBD) Close() error {
+	return nil
+}
+
+func (s *OpenBD) GetInfo(isbn string) (*bookscommon.Info, error) {
+	book, err := s.get(isbn)
+	if err != nil {
+		return nil, err
+	}
+	info := ToBookInfo(*book)
+	info.ISBN = isbn
+	return &info, nil
+}
+
+func (s *OpenBD) get(isbn string) (*Book, error) {
+	resp, err := s.client.Get(s.baseURL + "/v1/get?isbn=" + url.QueryEscape(isbn))
+	if err != nil {
+		return nil, fmt.Errorf("failed to request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad status: %s", resp.Status)
+	}
+
+	// 見つからない ISBN は [null] が返る
+	var books []*Book
+	if err := json.NewDecoder(resp.Body).Decode(&books); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON: %w", err)
+	}
+	if len(books) == 0 || books[0] == nil {
+		return nil, fmt.Errorf("%w: %s", bookscommon.ErrNotFoundBook, isbn)
+	}
+	return books[0], nil
+}
+
+// ToBookInfo は openBD のレスポンスを Info に変換する
+func ToBookInfo(book Book) bookscommon.Info {
+	detail := ToDetail(book)
+
+	info := bookscommon.Info{
+		Title:        detail.Title,
+		TitleReading: detail.TitleReading,
+		Contributors: detail.Contributors,
+		Description:  Description(book),
+		Publisher:    detail.Publisher,
+		Pages:        Pages(book),
+	}
+
+	if amount, err := strconv.ParseFloat(detail.Price.PriceAmount, 64); err == nil {
+		currency := detail.Price.CurrencyCode
+		if currency == "" {
+			currency = "JPY"
+		}
+		info.Price = bookscommon.Price{Amount: amount, Currency: currency}
+	}
+
+	for _, subject := range book.Onix.DescriptiveDetail.Subject {
+		if subject.SubjectHeadingText != "" {
+			info.Subjects = append(info.Subjects, subject.SubjectHeadingText)
+		}
+	}
+
+	dates := []string{book.Summary.PubDate}
+	for _, d := range book.Onix.PublishingDetail.PublishingDate {
+		if d.PublishingDateRole == publishingDateRolePublication {
+			dates = append([]string{d.Date}, dates...)
+		}
+	}
+	for _, d := range dates {
+		if date, err := StringToDate(d); err == nil {
+			info.Publishdate = date
+			break
+		}
+	}
+
+	// LanguageRole が 01 (本文の言語) のものだけを使う
+	var codes []string
+	for _, l := range book.Onix.DescriptiveDetail.Language {
+		if l.LanguageRole == languageRoleText || l.LanguageRole == "" {
+			codes = append(codes, l.LanguageCode)
+		}
+	}
+	info.Languages = bookscommon.ParseLanguages(codes)
+
+	if u, err := url.Parse(CoverURL(book)); err == nil {
+		info.Image.Source = *u
+	}
+
+	return info
+}
+
+// ToDetail はタイトル・読み・著者 (役割と読み付き)・出版社・価格を取り出す
+func ToDetail(book Book) Detail {
+	element := book.Onix.DescriptiveDetail.TitleDetail.TitleElement
+
+	title := strings.TrimSpace(element.TitleText.Content + " " + element.Subtitle.Content)
+	if title == "" {
+		title = book.Summary.Title
+	}
+	if book.Summary.Volume != "" && !strings.Contains(title, book.Summary.Volume) {
+		title = title + " " + book.Summary.Volume
+	}
+
+	publisher := book.Onix.PublishingDetail.Publisher.PublisherName
+	if publisher == "" {
+		publisher = book.Onix.PublishingDetail.Imprint.ImprintName
+	}
+	if publisher == "" {
+		publisher = book.Summary.Publisher
+	}
+
+	price := selectPrice(book.Onix.ProductSupply.SupplyDetail.Price)
+
+	var contributors []bookscommon.Contributor
+	for _, c := range book.Onix.DescriptiveDetail.Contributor {
+		if c.PersonName.Content != "" {
+			contributors = append(contributors, bookscommon.Contributor{
+				Name:    c.PersonName.Content,
+				Reading: c.PersonName.CollationKey,
+				Role:    c.Role(),
+			})
+		}
+	}
+	if len(contributors) == 0 {
+		// summary.author は "夏目漱石／著 山田太郎／訳" のように空白区切りで役割が付く
+		contributors = bookscommon.ParseContributors(strings.Join(strings.Fields(book.Summary.Author), ";"))
+	}
+
+	return Detail{
+		Title:        title,
+		TitleReading: element.TitleText.CollationKey,
+		Contributors: contributors,
+		Publisher:    publisher,
+		Price:        price,
+	}
+}
+
+// selectPrice は定価、希望小売価格、その他の順で価格を選ぶ
+func selectPrice(prices []Price) Price {
+	for _, priceType := range []string{priceTypeFixedRetail, priceTypeRRP} {
+		for _, p := range prices {
+			if p.PriceType == priceType && p.PriceAmount != "" {
+				return p
+			}
+		}
+	}
+	if len(prices) > 0 {
+		return prices[0]
+	}
+	return Price{}
+}
+
+// Pages は本文のページ数を返す (分からない場合は 0)
+func Pages(book Book) int {
+	for _, e := range book.Onix.DescriptiveDetail.Extent {
+		if e.ExtentType != extentTypeMainContentPageCount || e.ExtentUnit != extentUnitPages {
+			continue
+		}
+		if pages, err := strconv.Atoi(e.ExtentValue); err == nil {
+			return pages
+		}
+	}
+	return 0
+}
+
+// Description は詳細な紹介文、なければ短い紹介文を返す
+func Description(book Book) string {
+	var short string
+	for _, t := range book.Onix.CollateralDetail.TextContent {
+		switch t.TextType {
+		case textTypeLongDescription:
+			if t.Text != "" {
+				return t.Text
+			}
+		case textTypeShortDescription:
+			short = t.Text
+		}
+	}
+	if short != "" {
+		return short
+	}
+	return bookscommon.NoDescription
+}
+
+func CoverURL(book Book) string {
+	if book.Summary.Cover != "" {
+		return book.Summary.Cover
+	}
+	for _, r := range book.Onix.CollateralDetail.SupportingResource {
+		if r.ResourceContentType != resourceContentTypeFrontCover {
+			continue
+		}
+		for _, v := range r.ResourceVersion {
+			if v.ResourceLink != "" {
+				return v.ResourceLink
+			}
+		}
+	}
+	return ""
+}
+
+func StringToDate(s string) (bookscommon.Date, error) {
+	return bookscommon.ParseDate(s, "20060102", "2006-01-02", "200601", "2006-01", "2006")
+}
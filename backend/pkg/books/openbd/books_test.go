@@ -0,0 +1,104 @@
+package openbdbooks
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+	booksfixture "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/fixture"
+)
+
+func newTestOpenBD(t *testing.T) *OpenBD {
+	t.Helper()
+	transport, err := booksfixture.NewTransport(booksconfig.FixtureConfig{Mode: booksconfig.Replay, Dir: "testdata"}, nil)
+	if err != nil {
+		t.Fatalf("NewTransport() error = %v", err)
+	}
+	books, err := NewOpenBD(booksconfig.OpenBDBooksConfig{}, &http.Client{Transport: transport})
+	if err != nil {
+		t.Fatalf("NewOpenBD() error = %v", err)
+	}
+	return books
+}
+
+func TestGetInfo(t *testing.T) {
+	info, err := newTestOpenBD(t).GetInfo("9784101010137")
+	if err != nil {
+		t.Fatalf("GetInfo() error = %v", err)
+	}
+
+	if info.Title != "こころ" {
+		t.Errorf("Title = %q, want %q", info.Title, "こころ")
+	}
+	if info.TitleReading != "ココロ" {
+		t.Errorf("TitleReading = %q, want %q", info.TitleReading, "ココロ")
+	}
+	wantContributors := []bookscommon.Contributor{{Name: "夏目 漱石", Reading: "ナツメ ソウセキ", Role: bookscommon.Author}}
+	if !reflect.DeepEqual(info.Contributors, wantContributors) {
+		t.Errorf("Contributors = %+v, want %+v", info.Contributors, wantContributors)
+	}
+	if info.Publisher != "新潮社" {
+		t.Errorf("Publisher = %q, want %q", info.Publisher, "新潮社")
+	}
+	if want := (bookscommon.Price{Amount: 440, Currency: "JPY"}); info.Price != want {
+		t.Errorf("Price = %+v, want %+v", info.Price, want)
+	}
+	if info.Pages != 384 {
+		t.Errorf("Pages = %d, want 384", info.Pages)
+	}
+	// 詳細な紹介文を短い紹介文より優先する
+	if want := "親友を裏切って恋人を得たが、親友が自殺したために罪悪感に苦しみ、自らも死を選ぶ孤独な明治の知識人の内面を描いた作品。"; info.Description != want {
+		t.Errorf("Description = %q, want %q", info.Description, want)
+	}
+	if want := bookscommon.NewDate(time.Date(2004, time.March, 1, 0, 0, 0, 0, time.UTC), bookscommon.DateDay); info.Publishdate != want {
+		t.Errorf("Publishdate = %v, want %v", info.Publishdate, want)
+	}
+	if want := []bookscommon.Language{"ja"}; !reflect.DeepEqual(info.Languages, want) {
+		t.Errorf("Languages = %v, want %v", info.Languages, want)
+	}
+	if want := []string{"日本文学"}; !reflect.DeepEqual(info.Subjects, want) {
+		t.Errorf("Subjects = %v, want %v", info.Subjects, want)
+	}
+	if want := "https://cover.openbd.jp/9784101010137.jpg"; info.Image.Source.String() != want {
+		t.Errorf("Image.Source = %q, want %q", info.Image.Source.String(), want)
+	}
+}
+
+// ONIX に無い項目は summary から埋める
+func TestGetInfoSummaryFallback(t *testing.T) {
+	info, err := newTestOpenBD(t).GetInfo("9784003101179")
+	if err != nil {
+		t.Fatalf("GetInfo() error = %v", err)
+	}
+
+	if info.Title != "漱石文明論集" {
+		t.Errorf("Title = %q, want %q", info.Title, "漱石文明論集")
+	}
+	wantContributors := []bookscommon.Contributor{
+		{Name: "夏目漱石", Role: bookscommon.Author},
+		{Name: "三好行雄", Role: bookscommon.Editor},
+	}
+	if !reflect.DeepEqual(info.Contributors, wantContributors) {
+		t.Errorf("Contributors = %+v, want %+v", info.Contributors, wantContributors)
+	}
+	if info.Publisher != "岩波書店" {
+		t.Errorf("Publisher = %q, want %q", info.Publisher, "岩波書店")
+	}
+	if want := bookscommon.NewDate(time.Date(1986, time.October, 1, 0, 0, 0, 0, time.UTC), bookscommon.DateMonth); info.Publishdate != want {
+		t.Errorf("Publishdate = %v, want %v", info.Publishdate, want)
+	}
+	if info.Description != bookscommon.NoDescription {
+		t.Errorf("Description = %q, want %q", info.Description, bookscommon.NoDescription)
+	}
+}
+
+func TestGetInfoNotFound(t *testing.T) {
+	_, err := newTestOpenBD(t).GetInfo("9780000000002")
+	if !errors.Is(err, bookscommon.ErrNotFoundBook) {
+		t.Errorf("GetInfo() error = %v, want %v", err, bookscommon.ErrNotFoundBook)
+	}
+}
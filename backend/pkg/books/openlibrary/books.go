@@ -0,0 +1,308 @@
+package openlibrarybooks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+)
+
+const (
+	defaultBaseURL  = "https://openlibrary.org"
+	defaultCoverURL = "https://covers.openlibrary.org"
+)
+
+var errNotFound = errors.New("not found")
+
+type Key struct {
+	Key string `json:"key"`
+}
+
+// Text は "description" のように文字列と {"type": ..., "value": ...} のどちらでも返るフィールド
+type Text string
+
+func (t *Text) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*t = Text(s)
+		return nil
+	}
+	var v struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return fmt.Errorf("failed to decode text: %w", err)
+	}
+	*t = Text(v.Value)
+	return nil
+}
+
+type Edition struct {
+	Title       string   `json:"title"`
+	Subtitle    string   `json:"subtitle"`
+	Authors     []Key    `json:"authors"`
+	Works       []Key    `json:"works"`
+	PublishDate string   `json:"publish_date"`
+	Languages   []Key    `json:"languages"`
+	Description Text     `json:"description"`
+	Subjects    []string `json:"subjects"`
+	Covers      []int64  `json:"covers"`
+	Publishers  []string `json:"publishers"`
+	Pages       int      `json:"number_of_pages"`
+	EditionName string   `json:"edition_name"`
+}
+
+type Work struct {
+	Title       string   `json:"title"`
+	Description Text     `json:"description"`
+	Subjects    []string `json:"subjects"`
+	Covers      []int64  `json:"covers"`
+	Authors     []struct {
+		Author Key `json:"author"`
+	} `json:"authors"`
+}
+
+type Author struct {
+	Name string `json:"name"`
+}
+
+type OpenLibrary struct {
+	client   *http.Client
+	baseURL  string
+	coverURL string
+}
+
+func NewOpenLibrary(config booksconfig.OpenLibraryBooksConfig, client *http.Client) (*OpenLibrary, error) {
+	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+	coverURL := config.CoverURL
+	if coverURL == "" {
+		coverURL = defaultCoverURL
+	}
+	for _, u := range []string{baseURL, coverURL} {
+		if _, err := url.Parse(u); err != nil {
+			return nil, fmt.Errorf("failed to parse url: %w", err)
+		}
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &OpenLibrary{
+		client:   client,
+		baseURL:  strings.TrimSuffix(baseURL, "/"),
+		coverURL: strings.TrimSuffix(coverURL, "/"),
+	}, nil
+}
+
+func (s *OpenLibrary) Close() error {
+	return nil
+}
+
+func (s *OpenLibrary) GetInfo(isbn string) (*bookscommon.Info, error) {
+	var edition Edition
+	if err := s.get("/isbn/"+url.PathEscape(isbn)+".json", &edition); err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, fmt.Errorf("%w: %s", bookscommon.ErrNotFoundBook, isbn)
+		}
+		return nil, fmt.Errorf("failed to get edition: %w", err)
+	}
+
+	// エディションに無い情報はワークから補う
+	var work Work
+	if len(edition.Works) > 0 {
+		if err := s.get(edition.Works[0].Key+".json", &work); err != nil {
+			work = Work{}
+		}
+	}
+
+	title := strings.TrimSpace(edition.Title + " " + edition.Subtitle)
+	if title == "" {
+		title = work.Title
+	}
+
+	desc := string(edition.Description)
+	if desc == "" {
+		desc = string(work.Description)
+	}
+	if desc == "" {
+		desc = bookscommon.NoDescription
+	}
+
+	authorKeys := edition.Authors
+	if len(authorKeys) == 0 {
+		for _, a := range work.Authors {
+			authorKeys = append(authorKeys, a.Author)
+		}
+	}
+	var authors []string
+	for _, key := range authorKeys {
+		var author Author
+		if err := s.get(key.Key+".json", &author); err != nil || author.Name == "" {
+			continue
+		}
+		authors = append(authors, author.Name)
+	}
+
+	subjects := edition.Subjects
+	if len(subjects) == 0 {
+		subjects = work.Subjects
+	}
+
+	info := &bookscommon.Info{
+		ISBN:         isbn,
+		Title:        title,
+		Contributors: bookscommon.Authors(authors),
+		Description:  desc,
+		Pages:        edition.Pages,
+		Subjects:     subjects,
+		Edition:      edition.EditionName,
+	}
+	if len(edition.Publishers) > 0 {
+		info.Publisher = edition.Publishers[0]
+	}
+
+	if date, err := StringToDate(edition.PublishDate); err == nil {
+		info.Publishdate = date
+	}
+
+	var codes []string
+	for _, lang := range edition.Languages {
+		codes = append(codes, strings.TrimPrefix(lang.Key, "/languages/"))
+	}
+	info.Languages = bookscommon.ParseLanguages(codes)
+
+	covers := edition.Covers
+	if len(covers) == 0 {
+		covers = work.Covers
+	}
+	// 版によって表紙が違うので全て候補にし、最初のものを使う
+	for _, id := range covers {
+		// -1 はカバーが削除されたことを表す
+		if id <= 0 {
+			continue
+		}
+		u, err := url.Parse(fmt.Sprintf("%s/b/id/%d-L.jpg", s.coverURL, id))
+		if err != nil {
+			continue
+		}
+		if len(info.Covers) == 0 {
+			info.Image.Source = *u
+		}
+		info.Covers = append(info.Covers, bookscommon.CoverCandidate{Label: "L", URL: *u})
+	}
+
+	return info, nil
+}
+
+const searchMaxResults = 20
+
+type SearchResult struct {
+	Docs []struct {
+		Title            string   `json:"title"`
+		Subtitle         string   `json:"subtitle"`
+		AuthorName       []string `json:"author_name"`
+		ISBN             []string `json:"isbn"`
+		CoverID          int64    `json:"cover_i"`
+		FirstPublishYear int      `json:"first_publish_year"`
+		Language         []string `json:"language"`
+	} `json:"docs"`
+}
+
+func (s *OpenLibrary) Search(query bookscommon.Query) ([]bookscommon.Info, error) {
+	params := url.Values{
+		"limit":  {fmt.Sprint(searchMaxResults)},
+		"fields": {"title,subtitle,author_name,isbn,cover_i,first_publish_year,language"},
+	}
+	if query.Title != "" {
+		params.Set("title", query.Title)
+	}
+	if query.Author != "" {
+		params.Set("author", query.Author)
+	}
+	if len(params) == 2 {
+		return nil, fmt.Errorf("query is empty")
+	}
+
+	var result SearchResult
+	if err := s.get("/search.json?"+params.Encode(), &result); err != nil {
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+
+	var infos []bookscommon.Info
+	for _, doc := range result.Docs {
+		// ワークには複数のエディションの ISBN が入っているので ISBN-13 を優先する
+		var isbn string
+		for _, id := range doc.ISBN {
+			normalized := bookscommon.NormalizeISBN(id)
+			if normalized == "" {
+				continue
+			}
+			if isbn == "" || len(id) == 13 {
+				isbn = normalized
+			}
+			if len(id) == 13 {
+				break
+			}
+		}
+		if isbn == "" {
+			continue
+		}
+
+		info := bookscommon.Info{
+			ISBN:         isbn,
+			Title:        strings.TrimSpace(doc.Title + " " + doc.Subtitle),
+			Contributors: bookscommon.Authors(doc.AuthorName),
+		}
+		if doc.FirstPublishYear > 0 {
+			info.Publishdate = bookscommon.NewDate(time.Date(doc.FirstPublishYear, time.January, 1, 0, 0, 0, 0, time.UTC), bookscommon.DateYear)
+		}
+		info.Languages = bookscommon.ParseLanguages(doc.Language)
+		if doc.CoverID > 0 {
+			if u, err := url.Parse(fmt.Sprintf("%s/b/id/%d-M.jpg", s.coverURL, doc.CoverID)); err == nil {
+				info.Image.Source = *u
+			}
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (s *OpenLibrary) get(path string, v any) error {
+	resp, err := s.client.Get(s.baseURL + path)
+	if err != nil {
+		return fmt.Errorf("failed to request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("bad status: %s", resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode JSON: %w", err)
+	}
+	return nil
+}
+
+func StringToDate(s string) (bookscommon.Date, error) {
+	return bookscommon.ParseDate(s,
+		"2006-01-02",
+		"January 2, 2006",
+		"Jan 2, 2006",
+		"2 January 2006",
+		"January 2006",
+		"Jan 2006",
+		"2006-01",
+		"2006",
+	)
+}
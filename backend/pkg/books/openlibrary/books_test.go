@@ -0,0 +1,141 @@
+package openlibrarybooks
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+	booksfixture "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/fixture"
+)
+
+func newTestOpenLibrary(t *testing.T) *OpenLibrary {
+	t.Helper()
+	transport, err := booksfixture.NewTransport(booksconfig.FixtureConfig{Mode: booksconfig.Replay, Dir: "testdata"}, nil)
+	if err != nil {
+		t.Fatalf("NewTransport() error = %v", err)
+	}
+	books, err := NewOpenLibrary(booksconfig.OpenLibraryBooksConfig{}, &http.Client{Transport: transport})
+	if err != nil {
+		t.Fatalf("NewOpenLibrary() error = %v", err)
+	}
+	return books
+}
+
+func TestGetInfo(t *testing.T) {
+	info, err := newTestOpenLibrary(t).GetInfo("9780140328721")
+	if err != nil {
+		t.Fatalf("GetInfo() error = %v", err)
+	}
+
+	if info.Title != "Fantastic Mr. Fox" {
+		t.Errorf("Title = %q, want %q", info.Title, "Fantastic Mr. Fox")
+	}
+	wantContributors := []bookscommon.Contributor{{Name: "Roald Dahl", Role: bookscommon.Author}}
+	if !reflect.DeepEqual(info.Contributors, wantContributors) {
+		t.Errorf("Contributors = %+v, want %+v", info.Contributors, wantContributors)
+	}
+	// エディションに無い紹介文と件名はワークから補う
+	if want := "The main character of Fantastic Mr. Fox is an extremely clever anthropomorphized fox named Mr. Fox."; info.Description != want {
+		t.Errorf("Description = %q, want %q", info.Description, want)
+	}
+	if want := []string{"Animals", "Foxes", "Juvenile fiction"}; !reflect.DeepEqual(info.Subjects, want) {
+		t.Errorf("Subjects = %v, want %v", info.Subjects, want)
+	}
+	if info.Publisher != "Puffin" {
+		t.Errorf("Publisher = %q, want %q", info.Publisher, "Puffin")
+	}
+	if info.Pages != 96 {
+		t.Errorf("Pages = %d, want 96", info.Pages)
+	}
+	if want := bookscommon.NewDate(time.Date(1988, time.October, 1, 0, 0, 0, 0, time.UTC), bookscommon.DateDay); info.Publishdate != want {
+		t.Errorf("Publishdate = %v, want %v", info.Publishdate, want)
+	}
+	if want := []bookscommon.Language{"en"}; !reflect.DeepEqual(info.Languages, want) {
+		t.Errorf("Languages = %v, want %v", info.Languages, want)
+	}
+
+	// 削除された表紙 (-1) は候補にしない
+	var covers []string
+	for _, c := range info.Covers {
+		covers = append(covers, c.URL.String())
+	}
+	wantCovers := []string{
+		"https://covers.openlibrary.org/b/id/8739161-L.jpg",
+		"https://covers.openlibrary.org/b/id/8739162-L.jpg",
+	}
+	if !reflect.DeepEqual(covers, wantCovers) {
+		t.Errorf("Covers = %v, want %v", covers, wantCovers)
+	}
+	if info.Image.Source.String() != wantCovers[0] {
+		t.Errorf("Image.Source = %q, want %q", info.Image.Source.String(), wantCovers[0])
+	}
+}
+
+func TestGetInfoNotFound(t *testing.T) {
+	_, err := newTestOpenLibrary(t).GetInfo("9780000000002")
+	if !errors.Is(err, bookscommon.ErrNotFoundBook) {
+		t.Errorf("GetInfo() error = %v, want %v", err, bookscommon.ErrNotFoundBook)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	infos, err := newTestOpenLibrary(t).Search(bookscommon.Query{Title: "fantastic mr fox", Author: "dahl"})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	// ISBN の無いワークは除く
+	if len(infos) != 2 {
+		t.Fatalf("len(Search()) = %d, want 2", len(infos))
+	}
+
+	// ISBN-13 があればそれを使う
+	if infos[0].ISBN != "9780140328721" {
+		t.Errorf("ISBN = %q, want %q", infos[0].ISBN, "9780140328721")
+	}
+	if want := bookscommon.NewDate(time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC), bookscommon.DateYear); infos[0].Publishdate != want {
+		t.Errorf("Publishdate = %v, want %v", infos[0].Publishdate, want)
+	}
+	if want := []bookscommon.Language{"en", "es"}; !reflect.DeepEqual(infos[0].Languages, want) {
+		t.Errorf("Languages = %v, want %v", infos[0].Languages, want)
+	}
+	if want := "https://covers.openlibrary.org/b/id/6498519-M.jpg"; infos[0].Image.Source.String() != want {
+		t.Errorf("Image.Source = %q, want %q", infos[0].Image.Source.String(), want)
+	}
+
+	// ISBN-10 しか無い場合は ISBN-13 に揃える
+	if infos[1].ISBN != "9780140328721" {
+		t.Errorf("ISBN = %q, want %q", infos[1].ISBN, "9780140328721")
+	}
+	if want := "Fantastic Mr. Fox Adapted for the stage"; infos[1].Title != want {
+		t.Errorf("Title = %q, want %q", infos[1].Title, want)
+	}
+	if infos[1].Image.Source.String() != "" {
+		t.Errorf("Image.Source = %q, want empty", infos[1].Image.Source.String())
+	}
+}
+
+func TestStringToDate(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bookscommon.Date
+	}{
+		{"October 1, 1988", bookscommon.NewDate(time.Date(1988, time.October, 1, 0, 0, 0, 0, time.UTC), bookscommon.DateDay)},
+		{"Oct 1988", bookscommon.NewDate(time.Date(1988, time.October, 1, 0, 0, 0, 0, time.UTC), bookscommon.DateMonth)},
+		{"1988", bookscommon.NewDate(time.Date(1988, time.January, 1, 0, 0, 0, 0, time.UTC), bookscommon.DateYear)},
+	}
+	for _, tt := range tests {
+		got, err := StringToDate(tt.s)
+		if err != nil {
+			t.Errorf("StringToDate(%q) error = %v", tt.s, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("StringToDate(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
@@ -13,4 +13,6 @@ type Config struct {
 	AdminEmail      string `yaml:"admin_email"`
 	PomeriumJWKSURL string `yaml:"pomerium_jwks_url"`
 	FrontendURL     string `yaml:"frontend_url"`
+	// PublicURL は外から API に届く URL で、画像の URL の組み立てに使う
+	PublicURL string `yaml:"public_url"`
 }
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	book_management_systemv1 "github.com/nyahahanoha/BookManagementSystem/backend/api/book_management_system/v1"
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+	storeimage "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/image"
+)
+
+// maxCaptionLength は写真の説明の文字数の上限
+const maxCaptionLength = 1024
+
+func (s *BooksService) UploadAttachment(ctx context.Context, stream *connect.ClientStream[book_management_systemv1.UploadAttachmentRequest]) (*connect.Response[book_management_systemv1.UploadAttachmentResponse], error) {
+	var header *book_management_systemv1.UploadAttachmentRequest
+	var buf bytes.Buffer
+	for stream.Receive() {
+		msg := stream.Msg()
+		if header == nil {
+			header = msg
+		}
+		if buf.Len()+len(msg.Chunk) > maxCoverSize {
+			return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("attachment exceeds %d bytes", maxCoverSize))
+		}
+		buf.Write(msg.Chunk)
+	}
+	if err := stream.Err(); err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id or isbn is required"))
+	}
+	s.lg.Info("recieved request to Upload attachment", slog.String("isbn", header.Isbn), slog.String("id", header.Id), slog.Int("size", buf.Len()))
+	if header.Id == "" && header.Isbn == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id or isbn is required"))
+	}
+	if utf8.RuneCountInString(header.Caption) > maxCaptionLength {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("caption exceeds %d characters", maxCaptionLength))
+	}
+	if buf.Len() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("attachment is empty"))
+	}
+	// クライアントの申告ではなく中身から形式を判定する
+	contentType, err := storeimage.Sniff(buf.Bytes())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := storeimage.CheckSize(buf.Bytes()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	bookID, err := s.attachmentBookID(header.Id, header.Isbn)
+	if err != nil {
+		return nil, err
+	}
+	attachment, err := s.store.AddAttachment(bookscommon.Attachment{
+		BookID:  bookID,
+		Kind:    convertAttachmentKindFromProtobuf(header.Kind),
+		Caption: header.Caption,
+	}, buf.Bytes(), contentType)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to add attachment in store: %w", err)
+	}
+	return connect.NewResponse(&book_management_systemv1.UploadAttachmentResponse{
+		Attachment: s.convertAttachmentToProtobuf(attachment),
+	}), nil
+}
+
+func (s *BooksService) ListAttachments(ctx context.Context, req *connect.Request[book_management_systemv1.ListAttachmentsRequest]) (*connect.Response[book_management_systemv1.ListAttachmentsResponse], error) {
+	s.lg.Info("recieved request to List attachments", slog.String("isbn", req.Msg.Isbn), slog.String("id", req.Msg.Id))
+	if req.Msg.Id == "" && req.Msg.Isbn == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id or isbn is required"))
+	}
+	bookID, err := s.attachmentBookID(req.Msg.Id, req.Msg.Isbn)
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := s.listAttachments(bookID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&book_management_systemv1.ListAttachmentsResponse{
+		Attachments: attachments,
+	}), nil
+}
+
+func (s *BooksService) DeleteAttachment(ctx context.Context, req *connect.Request[book_management_systemv1.DeleteAttachmentRequest]) (*connect.Response[book_management_systemv1.DeleteAttachmentResponse], error) {
+	s.lg.Info("recieved request to Delete attachment", slog.String("isbn", req.Msg.Isbn), slog.String("id", req.Msg.Id), slog.String("attachment_id", req.Msg.AttachmentId))
+	if req.Msg.Id == "" && req.Msg.Isbn == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id or isbn is required"))
+	}
+	if req.Msg.AttachmentId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("attachment_id is required"))
+	}
+	bookID, err := s.attachmentBookID(req.Msg.Id, req.Msg.Isbn)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.DeleteAttachment(bookID, req.Msg.AttachmentId); errors.Is(err, storecommon.ErrNotFoundAttachment) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("attachment not found: %s", req.Msg.AttachmentId))
+	} else if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to delete attachment in store: %w", err)
+	}
+	return connect.NewResponse(&book_management_systemv1.DeleteAttachmentResponse{}), nil
+}
+
+func (s *BooksService) ReorderAttachments(ctx context.Context, req *connect.Request[book_management_systemv1.ReorderAttachmentsRequest]) (*connect.Response[book_management_systemv1.ReorderAttachmentsResponse], error) {
+	s.lg.Info("recieved request to Reorder attachments", slog.String("isbn", req.Msg.Isbn), slog.String("id", req.Msg.Id), slog.Int("count", len(req.Msg.AttachmentIds)))
+	if req.Msg.Id == "" && req.Msg.Isbn == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id or isbn is required"))
+	}
+	bookID, err := s.attachmentBookID(req.Msg.Id, req.Msg.Isbn)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.ReorderAttachments(bookID, req.Msg.AttachmentIds); errors.Is(err, storecommon.ErrAttachmentOrder) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	} else if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to reorder attachments in store: %w", err)
+	}
+	attachments, err := s.listAttachments(bookID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&book_management_systemv1.ReorderAttachmentsResponse{
+		Attachments: attachments,
+	}), nil
+}
+
+// attachmentBookID は写真を扱う本の ID を返す (本が無い場合は NotFound)
+func (s *BooksService) attachmentBookID(id, isbn string) (string, error) {
+	bookID, err := s.resolveBookID(id, isbn)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return "", fmt.Errorf("failed to resolve book: %w", err)
+	}
+	if _, err := s.store.Get(bookID); errors.Is(err, storecommon.ErrNotFoundBook) {
+		return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("book not found: %s", bookID))
+	} else if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return "", fmt.Errorf("failed to get book in store: %w", err)
+	}
+	return bookID, nil
+}
+
+func (s *BooksService) listAttachments(bookID string) ([]*book_management_systemv1.Attachment, error) {
+	attachments, err := s.store.GetAttachments(bookID)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to get attachments in store: %w", err)
+	}
+	res := make([]*book_management_systemv1.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		res = append(res, s.convertAttachmentToProtobuf(attachment))
+	}
+	return res, nil
+}
+
+func (s *BooksService) convertAttachmentToProtobuf(attachment bookscommon.Attachment) *book_management_systemv1.Attachment {
+	return &book_management_systemv1.Attachment{
+		Id:       attachment.ID,
+		Kind:     convertAttachmentKindToProtobuf(attachment.Kind),
+		Caption:  attachment.Caption,
+		Imageurl: s.pathURL(attachment.Path),
+		Width:    int32(attachment.Width),
+		Height:   int32(attachment.Height),
+		Position: int32(attachment.Position),
+	}
+}
+
+func convertAttachmentKindToProtobuf(kind bookscommon.AttachmentKind) book_management_systemv1.AttachmentKind {
+	switch kind {
+	case bookscommon.AttachmentBackCover:
+		return book_management_systemv1.AttachmentKind_BACK_COVER
+	case bookscommon.AttachmentSpine:
+		return book_management_systemv1.AttachmentKind_SPINE
+	case bookscommon.AttachmentInterior:
+		return book_management_systemv1.AttachmentKind_INTERIOR
+	case bookscommon.AttachmentDamage:
+		return book_management_systemv1.AttachmentKind_DAMAGE
+	default:
+		return book_management_systemv1.AttachmentKind_OTHER
+	}
+}
+
+func convertAttachmentKindFromProtobuf(kind book_management_systemv1.AttachmentKind) bookscommon.AttachmentKind {
+	switch kind {
+	case book_management_systemv1.AttachmentKind_BACK_COVER:
+		return bookscommon.AttachmentBackCover
+	case book_management_systemv1.AttachmentKind_SPINE:
+		return bookscommon.AttachmentSpine
+	case book_management_systemv1.AttachmentKind_INTERIOR:
+		return bookscommon.AttachmentInterior
+	case book_management_systemv1.AttachmentKind_DAMAGE:
+		return bookscommon.AttachmentDamage
+	default:
+		return bookscommon.AttachmentOther
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	book_management_systemv1 "github.com/nyahahanoha/BookManagementSystem/backend/api/book_management_system/v1"
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+)
+
+func (s *BooksService) ListAuthors(ctx context.Context, req *connect.Request[book_management_systemv1.ListAuthorsRequest]) (*connect.Response[book_management_systemv1.ListAuthorsResponse], error) {
+	s.lg.Info("recieved request to List authors", slog.String("query", req.Msg.Query))
+	records, err := s.store.ListAuthors(req.Msg.Query)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to list authors in store: %w", err)
+	}
+
+	authors := make([]*book_management_systemv1.Author, 0, len(records))
+	for _, record := range records {
+		authors = append(authors, convertAuthorToProtobuf(record))
+	}
+	return connect.NewResponse(&book_management_systemv1.ListAuthorsResponse{
+		Authors: authors,
+	}), nil
+}
+
+func (s *BooksService) ListBooksByAuthor(ctx context.Context, req *connect.Request[book_management_systemv1.ListBooksByAuthorRequest]) (*connect.Response[book_management_systemv1.ListBooksByAuthorResponse], error) {
+	s.lg.Info("recieved request to List books by author", slog.Int64("author_id", req.Msg.AuthorId), slog.String("name", req.Msg.Name))
+	authorID := req.Msg.AuthorId
+	if authorID == 0 {
+		if strings.TrimSpace(req.Msg.Name) == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("author_id or name is required"))
+		}
+		id, err := s.store.ResolveAuthor(req.Msg.Name)
+		if err != nil {
+			return nil, s.authorError(err)
+		}
+		authorID = id
+	}
+
+	record, err := s.store.GetAuthor(authorID)
+	if err != nil {
+		return nil, s.authorError(err)
+	}
+	books, err := s.store.GetBooksByAuthor(authorID)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to get books by author in store: %w", err)
+	}
+
+	res := make([]*book_management_systemv1.Book, 0, len(books))
+	for _, info := range books {
+		res = append(res, s.convertInfoToProtobuf(info))
+	}
+	return connect.NewResponse(&book_management_systemv1.ListBooksByAuthorResponse{
+		Author: convertAuthorToProtobuf(record),
+		Books:  res,
+	}), nil
+}
+
+func (s *BooksService) UpdateAuthor(ctx context.Context, req *connect.Request[book_management_systemv1.UpdateAuthorRequest]) (*connect.Response[book_management_systemv1.UpdateAuthorResponse], error) {
+	author := req.Msg.Author
+	if author == nil || author.Id == 0 || strings.TrimSpace(author.Name) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("author id and name are required"))
+	}
+	s.lg.Info("recieved request to Update author", slog.Int64("id", author.Id), slog.String("name", author.Name))
+
+	if err := s.store.UpdateAuthor(bookscommon.AuthorRecord{
+		ID:      author.Id,
+		Name:    strings.TrimSpace(author.Name),
+		Reading: strings.TrimSpace(author.Reading),
+		Aliases: author.Aliases,
+	}); err != nil {
+		return nil, s.authorError(err)
+	}
+
+	record, err := s.store.GetAuthor(author.Id)
+	if err != nil {
+		return nil, s.authorError(err)
+	}
+	return connect.NewResponse(&book_management_systemv1.UpdateAuthorResponse{
+		Author: convertAuthorToProtobuf(record),
+	}), nil
+}
+
+func (s *BooksService) MergeAuthors(ctx context.Context, req *connect.Request[book_management_systemv1.MergeAuthorsRequest]) (*connect.Response[book_management_systemv1.MergeAuthorsResponse], error) {
+	s.lg.Info("recieved request to Merge authors", slog.Int64("target_id", req.Msg.TargetId), slog.Any("source_ids", req.Msg.SourceIds))
+	if req.Msg.TargetId == 0 || len(req.Msg.SourceIds) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("target_id and source_ids are required"))
+	}
+
+	if err := s.store.MergeAuthors(req.Msg.TargetId, req.Msg.SourceIds); err != nil {
+		return nil, s.authorError(err)
+	}
+
+	record, err := s.store.GetAuthor(req.Msg.TargetId)
+	if err != nil {
+		return nil, s.authorError(err)
+	}
+	return connect.NewResponse(&book_management_systemv1.MergeAuthorsResponse{
+		Author: convertAuthorToProtobuf(record),
+	}), nil
+}
+
+// authorError はストアのエラーを connect のエラーに変換する
+func (s *BooksService) authorError(err error) error {
+	switch {
+	case errors.Is(err, storecommon.ErrNotFoundAuthor):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, storecommon.ErrAliasConflict):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	default:
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return fmt.Errorf("failed to access authors in store: %w", err)
+	}
+}
+
+func convertAuthorToProtobuf(record bookscommon.AuthorRecord) *book_management_systemv1.Author {
+	return &book_management_systemv1.Author{
+		Id:      record.ID,
+		Name:    record.Name,
+		Reading: record.Reading,
+		Aliases: record.Aliases,
+	}
+}
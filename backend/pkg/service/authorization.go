@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"log/slog"
@@ -28,53 +29,65 @@ func NewAuthInterceptor(jwks jwk.Set, logger *slog.Logger, addminEmail string) c
 
 func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		jwtHeader := req.Header().Get("X-Pomerium-Jwt-Assertion")
-		if jwtHeader == "" {
-			i.Logger.Warn("missing X-Pomerium-Jwt-Assertion header")
-			return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("missing X-Pomerium-Jwt-Assertion header"))
+		if err := i.authorize(req.Spec().Procedure, req.Header()); err != nil {
+			return nil, err
 		}
+		return next(ctx, req)
+	}
+}
 
-		token, err := jwt.Parse(jwtHeader, func(token *jwt.Token) (interface{}, error) {
-			kid, ok := token.Header["kid"].(string)
-			if !ok {
-				return nil, fmt.Errorf("missing kid in header")
-			}
-			key, found := i.JWKS.LookupKeyID(kid)
-			if !found {
-				return nil, fmt.Errorf("key not found: %s", kid)
-			}
-			var pubkey interface{}
-			if err := key.Raw(&pubkey); err != nil {
-				return nil, fmt.Errorf("failed to get raw key: %w", err)
-			}
-			return pubkey, nil
-		})
+// authorize は Pomerium の JWT を検証し、閲覧以外の手続きは管理者だけに許可する
+func (i *AuthInterceptor) authorize(procedure string, header http.Header) error {
+	jwtHeader := header.Get("X-Pomerium-Jwt-Assertion")
+	if jwtHeader == "" {
+		i.Logger.Warn("missing X-Pomerium-Jwt-Assertion header")
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("missing X-Pomerium-Jwt-Assertion header"))
+	}
 
-		if err != nil {
-			i.Logger.Warn("invalid Pomerium JWT", slog.String("error", err.Error()))
-			return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token: %v", err))
+	token, err := jwt.Parse(jwtHeader, func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, fmt.Errorf("missing kid in header")
 		}
-
-		if !token.Valid {
-			i.Logger.Warn("unauthorized access attempt via Pomerium header")
-			return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token"))
+		key, found := i.JWKS.LookupKeyID(kid)
+		if !found {
+			return nil, fmt.Errorf("key not found: %s", kid)
 		}
+		var pubkey interface{}
+		if err := key.Raw(&pubkey); err != nil {
+			return nil, fmt.Errorf("failed to get raw key: %w", err)
+		}
+		return pubkey, nil
+	})
+
+	if err != nil {
+		i.Logger.Warn("invalid Pomerium JWT", slog.String("error", err.Error()))
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token: %v", err))
+	}
 
-		claims, _ := token.Claims.(jwt.MapClaims)
-		if email, ok := claims["email"].(string); ok {
-			if strings.HasSuffix(req.Spec().Procedure, "GetAllBooks") ||
-				strings.HasSuffix(req.Spec().Procedure, "GetBook") ||
-				strings.HasSuffix(req.Spec().Procedure, "SearchBook") {
-				return next(ctx, req)
-			}
-			if email != i.addminEmail {
-				i.Logger.Warn("forbidden access attempt via Pomerium", slog.String("email", email))
-				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("forbidden"))
-			}
-			return next(ctx, req)
-		} else {
-			return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("email claim not found"))
+	if !token.Valid {
+		i.Logger.Warn("unauthorized access attempt via Pomerium header")
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token"))
+	}
+
+	claims, _ := token.Claims.(jwt.MapClaims)
+	if email, ok := claims["email"].(string); ok {
+		if strings.HasSuffix(procedure, "GetAllBooks") ||
+			strings.HasSuffix(procedure, "GetBook") ||
+			strings.HasSuffix(procedure, "SearchBook") ||
+			strings.HasSuffix(procedure, "ListAuthors") ||
+			strings.HasSuffix(procedure, "ListBooksByAuthor") ||
+			strings.HasSuffix(procedure, "BrowseClassification") ||
+			strings.HasSuffix(procedure, "ListAttachments") {
+			return nil
+		}
+		if email != i.addminEmail {
+			i.Logger.Warn("forbidden access attempt via Pomerium", slog.String("email", email))
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("forbidden"))
 		}
+		return nil
+	} else {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("email claim not found"))
 	}
 }
 
@@ -83,5 +96,10 @@ func (i *AuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc)
 }
 
 func (i *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
-	return next
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := i.authorize(conn.Spec().Procedure, conn.RequestHeader()); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
 }
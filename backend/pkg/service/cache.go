@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	book_management_systemv1 "github.com/nyahahanoha/BookManagementSystem/backend/api/book_management_system/v1"
+)
+
+func (s *BooksService) ListProviderCache(ctx context.Context, req *connect.Request[book_management_systemv1.ListProviderCacheRequest]) (*connect.Response[book_management_systemv1.ListProviderCacheResponse], error) {
+	s.lg.Info("recieved request to List provider cache", slog.String("isbn", req.Msg.Isbn))
+	entries, err := s.store.ListCache(req.Msg.Isbn)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to list provider cache in store: %w", err)
+	}
+
+	res := make([]*book_management_systemv1.ProviderCacheEntry, 0, len(entries))
+	for _, entry := range entries {
+		res = append(res, &book_management_systemv1.ProviderCacheEntry{
+			Source:      entry.Source,
+			Isbn:        entry.ISBN,
+			NotFound:    entry.NotFound,
+			CreatedTime: entry.CreatedAt.Format(time.RFC3339),
+			ExpiresTime: entry.ExpiresAt.Format(time.RFC3339),
+			Response:    string(entry.Response),
+		})
+	}
+	return connect.NewResponse(&book_management_systemv1.ListProviderCacheResponse{
+		Entries: res,
+	}), nil
+}
+
+func (s *BooksService) InvalidateProviderCache(ctx context.Context, req *connect.Request[book_management_systemv1.InvalidateProviderCacheRequest]) (*connect.Response[book_management_systemv1.InvalidateProviderCacheResponse], error) {
+	s.lg.Info("recieved request to Invalidate provider cache", slog.String("isbn", req.Msg.Isbn), slog.String("source", req.Msg.Source))
+	if err := s.store.DeleteCache(req.Msg.Source, req.Msg.Isbn); err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to invalidate provider cache in store: %w", err)
+	}
+	return connect.NewResponse(&book_management_systemv1.InvalidateProviderCacheResponse{}), nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	book_management_systemv1 "github.com/nyahahanoha/BookManagementSystem/backend/api/book_management_system/v1"
+	"github.com/nyahahanoha/BookManagementSystem/backend/pkg/books"
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+)
+
+func (s *BooksService) SearchCatalog(ctx context.Context, req *connect.Request[book_management_systemv1.SearchCatalogRequest]) (*connect.Response[book_management_systemv1.SearchCatalogResponse], error) {
+	s.lg.Info("recieved request to Search catalog", slog.String("title", req.Msg.Title), slog.String("author", req.Msg.Author))
+	if req.Msg.Title == "" && req.Msg.Author == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("title or author is required"))
+	}
+	query := bookscommon.Query{
+		Title:  req.Msg.Title,
+		Author: req.Msg.Author,
+	}
+
+	var candidates []*book_management_systemv1.CatalogCandidate
+	byISBN := make(map[string]*book_management_systemv1.CatalogCandidate)
+	for _, b := range s.books {
+		searcher, ok := b.Books.(books.Searcher)
+		if !ok {
+			continue
+		}
+		infos, err := searcher.Search(query)
+		if errors.Is(err, bookscommon.ErrNotSupported) {
+			continue
+		} else if err != nil {
+			s.lg.Warn("failed to search catalog", slog.String("source", b.Name), slog.String("err", err.Error()))
+			continue
+		}
+
+		for _, info := range infos {
+			// 同じ版は最初に見つかったものを残し、足りない情報だけ補う
+			if candidate, ok := byISBN[info.ISBN]; ok {
+				candidate.Sources = append(candidate.Sources, b.Name)
+				if candidate.Book.Imageurl == "" {
+					candidate.Book.Imageurl = info.Image.Source.String()
+				}
+				if len(candidate.Book.Contributors) == 0 {
+					book := s.convertInfoToProtobuf(info)
+					candidate.Book.Authors = book.Authors
+					candidate.Book.Contributors = book.Contributors
+				}
+				continue
+			}
+			book := s.convertInfoToProtobuf(info)
+			book.Imageurl = info.Image.Source.String()
+			candidate := &book_management_systemv1.CatalogCandidate{
+				Book:    book,
+				Sources: []string{b.Name},
+			}
+			byISBN[info.ISBN] = candidate
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return connect.NewResponse(&book_management_systemv1.SearchCatalogResponse{
+		Candidates: candidates,
+	}), nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"connectrpc.com/connect"
+	book_management_systemv1 "github.com/nyahahanoha/BookManagementSystem/backend/api/book_management_system/v1"
+	booksndc "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/ndc"
+)
+
+func (s *BooksService) BrowseClassification(ctx context.Context, req *connect.Request[book_management_systemv1.BrowseClassificationRequest]) (*connect.Response[book_management_systemv1.BrowseClassificationResponse], error) {
+	s.lg.Info("recieved request to Browse classification", slog.String("prefix", req.Msg.Prefix))
+	prefix := req.Msg.Prefix
+	if !booksndc.IsPrefix(prefix) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid prefix: %s", prefix))
+	}
+
+	// 目まで下りたら本を返す
+	if len(prefix) == 3 {
+		books, err := s.store.GetBooksByClassification(prefix)
+		if err != nil {
+			s.lg.Error("internal server error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("failed to get books by classification in store: %w", err)
+		}
+		res := make([]*book_management_systemv1.Book, 0, len(books))
+		for _, info := range books {
+			res = append(res, s.convertInfoToProtobuf(info))
+		}
+		return connect.NewResponse(&book_management_systemv1.BrowseClassificationResponse{
+			Books: res,
+		}), nil
+	}
+
+	counts, err := s.store.CountClassification(prefix)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to count classification in store: %w", err)
+	}
+	codes := make([]string, 0, len(counts))
+	for code := range counts {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	nodes := make([]*book_management_systemv1.ClassificationNode, 0, len(codes))
+	for _, code := range codes {
+		nodes = append(nodes, &book_management_systemv1.ClassificationNode{
+			Code:  code,
+			Label: booksndc.Label(code),
+			Count: int32(counts[code]),
+		})
+	}
+	return connect.NewResponse(&book_management_systemv1.BrowseClassificationResponse{
+		Nodes: nodes,
+	}), nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"strings"
+
+	book_management_systemv1 "github.com/nyahahanoha/BookManagementSystem/backend/api/book_management_system/v1"
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+)
+
+func convertContributorToProtobuf(c bookscommon.Contributor) *book_management_systemv1.Contributor {
+	var role book_management_systemv1.ContributorRole
+	switch c.Role {
+	case bookscommon.Author:
+		role = book_management_systemv1.ContributorRole_AUTHOR
+	case bookscommon.Translator:
+		role = book_management_systemv1.ContributorRole_TRANSLATOR
+	case bookscommon.Illustrator:
+		role = book_management_systemv1.ContributorRole_ILLUSTRATOR
+	case bookscommon.Editor:
+		role = book_management_systemv1.ContributorRole_EDITOR
+	case bookscommon.OriginalCreator:
+		role = book_management_systemv1.ContributorRole_ORIGINAL_CREATOR
+	default:
+		role = book_management_systemv1.ContributorRole_CONTRIBUTOR_ROLE_UNKNOWN
+	}
+	return &book_management_systemv1.Contributor{
+		Name:     c.Name,
+		Reading:  c.Reading,
+		Role:     role,
+		AuthorId: c.AuthorID,
+	}
+}
+
+// convertContributorFromProtobuf は役割が指定されていない場合は著者とする
+func convertContributorFromProtobuf(c *book_management_systemv1.Contributor) bookscommon.Contributor {
+	var role bookscommon.ContributorRole
+	switch c.GetRole() {
+	case book_management_systemv1.ContributorRole_TRANSLATOR:
+		role = bookscommon.Translator
+	case book_management_systemv1.ContributorRole_ILLUSTRATOR:
+		role = bookscommon.Illustrator
+	case book_management_systemv1.ContributorRole_EDITOR:
+		role = bookscommon.Editor
+	case book_management_systemv1.ContributorRole_ORIGINAL_CREATOR:
+		role = bookscommon.OriginalCreator
+	default:
+		role = bookscommon.Author
+	}
+	return bookscommon.Contributor{
+		Name:    c.GetName(),
+		Reading: strings.TrimSpace(c.GetReading()),
+		Role:    role,
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	"connectrpc.com/connect"
+	book_management_systemv1 "github.com/nyahahanoha/BookManagementSystem/backend/api/book_management_system/v1"
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+	storeimage "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/image"
+)
+
+// maxCoverSize はアップロードできる表紙の大きさの上限
+const maxCoverSize = 10 << 20
+
+func (s *BooksService) UploadCover(ctx context.Context, stream *connect.ClientStream[book_management_systemv1.UploadCoverRequest]) (*connect.Response[book_management_systemv1.UploadCoverResponse], error) {
+	var id, isbn string
+	var buf bytes.Buffer
+	for stream.Receive() {
+		msg := stream.Msg()
+		if id == "" && isbn == "" {
+			id, isbn = msg.Id, msg.Isbn
+		}
+		if buf.Len()+len(msg.Chunk) > maxCoverSize {
+			return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("cover exceeds %d bytes", maxCoverSize))
+		}
+		buf.Write(msg.Chunk)
+	}
+	if err := stream.Err(); err != nil {
+		return nil, err
+	}
+	s.lg.Info("recieved request to Upload cover", slog.String("isbn", isbn), slog.String("id", id), slog.Int("size", buf.Len()))
+	if id == "" && isbn == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id or isbn is required"))
+	}
+	if buf.Len() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cover is empty"))
+	}
+	// クライアントの申告ではなく中身から形式を判定する
+	contentType, err := storeimage.Sniff(buf.Bytes())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := storeimage.CheckSize(buf.Bytes()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	bookID, err := s.resolveBookID(id, isbn)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to resolve book: %w", err)
+	}
+	if _, err := s.store.Get(bookID); errors.Is(err, storecommon.ErrNotFoundBook) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("book not found: %s", bookID))
+	} else if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to get book in store: %w", err)
+	}
+
+	if err := s.store.UploadImage(bookID, &buf, contentType); err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to upload image in store: %w", err)
+	}
+	info, err := s.store.Get(bookID)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to get book in store: %w", err)
+	}
+	return connect.NewResponse(&book_management_systemv1.UploadCoverResponse{
+		Book: s.convertInfoToProtobuf(info),
+	}), nil
+}
+
+func (s *BooksService) ListCoverCandidates(ctx context.Context, req *connect.Request[book_management_systemv1.ListCoverCandidatesRequest]) (*connect.Response[book_management_systemv1.ListCoverCandidatesResponse], error) {
+	s.lg.Info("recieved request to List cover candidates", slog.String("isbn", req.Msg.Isbn), slog.String("id", req.Msg.Id))
+	if req.Msg.Id == "" && req.Msg.Isbn == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id or isbn is required"))
+	}
+	bookID, err := s.resolveBookID(req.Msg.Id, req.Msg.Isbn)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to resolve book: %w", err)
+	}
+	info, err := s.store.Get(bookID)
+	if errors.Is(err, storecommon.ErrNotFoundBook) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("book not found: %s", bookID))
+	} else if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to get book in store: %w", err)
+	}
+
+	candidates, err := s.store.CoverCandidates(ctx, bookID)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to get cover candidates in store: %w", err)
+	}
+	// アップロードした表紙や仮の表紙は Source が残っていても候補から選んだものではない
+	selected := info.Image.Origin == bookscommon.ImageProvider || info.Image.Origin == bookscommon.ImageSelected
+	res := make([]*book_management_systemv1.CoverCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		res = append(res, &book_management_systemv1.CoverCandidate{
+			Source:   candidate.Source,
+			Label:    candidate.Label,
+			Url:      candidate.URL.String(),
+			Width:    int32(candidate.Width),
+			Height:   int32(candidate.Height),
+			Selected: selected && candidate.URL.String() == info.Image.Source.String(),
+		})
+	}
+	return connect.NewResponse(&book_management_systemv1.ListCoverCandidatesResponse{
+		Candidates: res,
+	}), nil
+}
+
+func (s *BooksService) SelectCover(ctx context.Context, req *connect.Request[book_management_systemv1.SelectCoverRequest]) (*connect.Response[book_management_systemv1.SelectCoverResponse], error) {
+	s.lg.Info("recieved request to Select cover", slog.String("isbn", req.Msg.Isbn), slog.String("id", req.Msg.Id), slog.String("url", req.Msg.Url))
+	if req.Msg.Id == "" && req.Msg.Isbn == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id or isbn is required"))
+	}
+	u, err := url.Parse(req.Msg.Url)
+	if err != nil || req.Msg.Url == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid url: %q", req.Msg.Url))
+	}
+	bookID, err := s.resolveBookID(req.Msg.Id, req.Msg.Isbn)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to resolve book: %w", err)
+	}
+	if _, err := s.store.Get(bookID); errors.Is(err, storecommon.ErrNotFoundBook) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("book not found: %s", bookID))
+	} else if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to get book in store: %w", err)
+	}
+
+	if err := s.store.SelectCover(ctx, bookID, *u); errors.Is(err, storecommon.ErrNotFoundCover) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("cover candidate not found: %s", req.Msg.Url))
+	} else if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to select cover in store: %w", err)
+	}
+	info, err := s.store.Get(bookID)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to get book in store: %w", err)
+	}
+	return connect.NewResponse(&book_management_systemv1.SelectCoverResponse{
+		Book: s.convertInfoToProtobuf(info),
+	}), nil
+}
+
+func convertImageOriginToProtobuf(origin bookscommon.ImageOrigin) book_management_systemv1.ImageOrigin {
+	switch origin {
+	case bookscommon.ImageProvider:
+		return book_management_systemv1.ImageOrigin_PROVIDER
+	case bookscommon.ImageUser:
+		return book_management_systemv1.ImageOrigin_USER
+	case bookscommon.ImageGenerated:
+		return book_management_systemv1.ImageOrigin_GENERATED
+	case bookscommon.ImageSelected:
+		return book_management_systemv1.ImageOrigin_SELECTED
+	default:
+		return book_management_systemv1.ImageOrigin_IMAGE_ORIGIN_UNKNOWN
+	}
+}
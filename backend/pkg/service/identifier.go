@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	book_management_systemv1 "github.com/nyahahanoha/BookManagementSystem/backend/api/book_management_system/v1"
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksndc "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/ndc"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+)
+
+func (s *BooksService) CreateBook(ctx context.Context, req *connect.Request[book_management_systemv1.CreateBookRequest]) (*connect.Response[book_management_systemv1.CreateBookResponse], error) {
+	book := req.Msg.Book
+	if book == nil || strings.TrimSpace(book.Title) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("title is required"))
+	}
+	s.lg.Info("recieved request to Create book", slog.String("title", book.Title))
+
+	info := bookscommon.Info{
+		Title:        strings.TrimSpace(book.Title),
+		TitleReading: strings.TrimSpace(book.TitleReading),
+		Description:  book.Description,
+		Publisher:    book.Publisher,
+		Pages:        int(book.Pages),
+		Subjects:     book.Subjects,
+		Edition:      book.Edition,
+		Series:       book.Series,
+		NDC:          booksndc.Normalize(book.Ndc),
+	}
+	if book.Price != nil {
+		info.Price = bookscommon.Price{
+			Amount:   book.Price.Amount,
+			Currency: book.Price.Currency,
+		}
+	}
+	if info.Description == "" {
+		info.Description = bookscommon.NoDescription
+	}
+	languages, err := convertLanguagesFromProtobuf(book)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	info.Languages = languages
+	// contributors が無い場合は authors を著者として扱う
+	if len(book.Contributors) > 0 {
+		for _, c := range book.Contributors {
+			if contributor := convertContributorFromProtobuf(c); contributor.Name != "" {
+				info.Contributors = append(info.Contributors, contributor)
+			}
+		}
+	} else {
+		info.Contributors = bookscommon.Authors(book.Authors)
+	}
+
+	identifiers := make([]*book_management_systemv1.Identifier, 0, len(book.Identifiers)+1)
+	if book.Isbn != "" {
+		identifiers = append(identifiers, &book_management_systemv1.Identifier{
+			Type:  book_management_systemv1.IdentifierType_ISBN,
+			Value: book.Isbn,
+		})
+	}
+	identifiers = append(identifiers, book.Identifiers...)
+	seen := make(map[bookscommon.Identifier]bool)
+	for _, ident := range identifiers {
+		id, err := convertIdentifierFromProtobuf(ident)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if id.Type == bookscommon.ISBN && info.ISBN == "" {
+			info.ISBN = id.Value
+		}
+		info.Identifiers = append(info.Identifiers, id)
+	}
+
+	if book.Publishdate != "" {
+		// 手入力の出版日は年、年月、年月日のどれでも受け付ける
+		date, err := bookscommon.ParseDate(book.Publishdate)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid publishdate: %s", book.Publishdate))
+		}
+		info.Publishdate = date
+	}
+	if book.Imageurl != "" {
+		u, err := url.Parse(book.Imageurl)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid imageurl: %w", err))
+		}
+		info.Image.Source = *u
+	}
+
+	// 既に登録されている識別子は受け付けない
+	for _, id := range info.Identifiers {
+		_, err := s.store.Resolve(id)
+		if err == nil {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("book already exists: %s %s", id.Type, id.Value))
+		} else if !errors.Is(err, storecommon.ErrNotFoundBook) {
+			s.lg.Error("internal server error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("failed to resolve identifier: %w", err)
+		}
+	}
+	info.ID = newBookID(info)
+
+	if err := s.store.Put(info); err != nil {
+		s.lg.Error("failed to put info in store", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to put info in store: %w", err)
+	}
+	info.Image.Path, err = s.store.ImagePath(info.ID)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to get image path: %w", err)
+	}
+	res := s.convertInfoToProtobuf(info)
+	return connect.NewResponse(&book_management_systemv1.CreateBookResponse{
+		Book: res,
+	}), nil
+}
+
+// newBookID は新しい本の ID を決める
+// ISBN がある本は ISBN をそのまま使う
+func newBookID(info bookscommon.Info) string {
+	if info.ISBN != "" {
+		return info.ISBN
+	}
+	return uuid.NewString()
+}
+
+// bookID は登録済みの本と同じ識別子があればその ID を、無ければ新しい ID を返す
+func (s *BooksService) bookID(info bookscommon.Info) (string, error) {
+	for _, id := range info.Identifiers {
+		bookID, err := s.store.Resolve(id)
+		if err == nil {
+			return bookID, nil
+		} else if !errors.Is(err, storecommon.ErrNotFoundBook) {
+			return "", fmt.Errorf("failed to resolve identifier: %w", err)
+		}
+	}
+	return newBookID(info), nil
+}
+
+// resolveBookID はリクエストの id か isbn から本の ID を探す
+// 見つからない場合は isbn をそのまま ID として扱う
+func (s *BooksService) resolveBookID(id, isbn string) (string, error) {
+	if id != "" {
+		return id, nil
+	}
+	for _, value := range []string{isbn, bookscommon.NormalizeISBN(isbn)} {
+		if value == "" {
+			continue
+		}
+		bookID, err := s.store.Resolve(bookscommon.Identifier{Type: bookscommon.ISBN, Value: value})
+		if err == nil {
+			return bookID, nil
+		} else if !errors.Is(err, storecommon.ErrNotFoundBook) {
+			return "", fmt.Errorf("failed to resolve isbn: %w", err)
+		}
+	}
+	return isbn, nil
+}
+
+func convertIdentifierFromProtobuf(ident *book_management_systemv1.Identifier) (bookscommon.Identifier, error) {
+	var t bookscommon.IdentifierType
+	switch ident.GetType() {
+	case book_management_systemv1.IdentifierType_ISBN:
+		t = bookscommon.ISBN
+	case book_management_systemv1.IdentifierType_JPNO:
+		t = bookscommon.JPNO
+	case book_management_systemv1.IdentifierType_NCID:
+		t = bookscommon.NCID
+	case book_management_systemv1.IdentifierType_ASIN:
+		t = bookscommon.ASIN
+	case book_management_systemv1.IdentifierType_ISSN:
+		t = bookscommon.ISSN
+	case book_management_systemv1.IdentifierType_OCLC:
+		t = bookscommon.OCLC
+	default:
+		return bookscommon.Identifier{}, fmt.Errorf("unknown identifier type: %s", ident.GetType())
+	}
+	id := bookscommon.NormalizeIdentifier(bookscommon.Identifier{Type: t, Value: ident.GetValue()})
+	if id.Value == "" {
+		return bookscommon.Identifier{}, fmt.Errorf("invalid %s: %q", t, ident.GetValue())
+	}
+	return id, nil
+}
+
+func convertIdentifierToProtobuf(id bookscommon.Identifier) *book_management_systemv1.Identifier {
+	var t book_management_systemv1.IdentifierType
+	switch id.Type {
+	case bookscommon.ISBN:
+		t = book_management_systemv1.IdentifierType_ISBN
+	case bookscommon.JPNO:
+		t = book_management_systemv1.IdentifierType_JPNO
+	case bookscommon.NCID:
+		t = book_management_systemv1.IdentifierType_NCID
+	case bookscommon.ASIN:
+		t = book_management_systemv1.IdentifierType_ASIN
+	case bookscommon.ISSN:
+		t = book_management_systemv1.IdentifierType_ISSN
+	case bookscommon.OCLC:
+		t = book_management_systemv1.IdentifierType_OCLC
+	default:
+		t = book_management_systemv1.IdentifierType_IDENTIFIER_TYPE_UNKNOWN
+	}
+	return &book_management_systemv1.Identifier{
+		Type:  t,
+		Value: id.Value,
+	}
+}
+
+func convertDatePrecisionToProtobuf(precision bookscommon.DatePrecision) book_management_systemv1.DatePrecision {
+	switch precision {
+	case bookscommon.DateYear:
+		return book_management_systemv1.DatePrecision_YEAR
+	case bookscommon.DateMonth:
+		return book_management_systemv1.DatePrecision_MONTH
+	case bookscommon.DateDay:
+		return book_management_systemv1.DatePrecision_DAY
+	default:
+		return book_management_systemv1.DatePrecision_DATE_PRECISION_UNKNOWN
+	}
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"errors"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+	storeimage "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/image"
+)
+
+// ImageHandler は /images/ 以下の画像を ObjectStore から返す
+// ETag と Last-Modified による条件付きリクエストと Range に対応する
+func (s *BooksService) ImageHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		name := strings.TrimPrefix(r.URL.Path, "/images/")
+		image, err := s.openImage(w, r, name)
+		if errors.Is(err, storecommon.ErrNotFoundImage) {
+			http.NotFound(w, r)
+			return
+		} else if err != nil {
+			s.lg.Error("failed to open image", slog.String("name", name), slog.String("err", err.Error()))
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		defer func() {
+			if err := image.Close(); err != nil {
+				s.lg.Error("failed to close image", slog.String("err", err.Error()))
+			}
+		}()
+
+		ct := image.ContentType
+		if ct == "" {
+			ct = mime.TypeByExtension(path.Ext(name))
+		}
+		if ct != "" {
+			w.Header().Set("Content-Type", ct)
+		}
+		if image.ETag != "" {
+			w.Header().Set("ETag", `"`+image.ETag+`"`)
+		}
+		// 表紙を差し替えても URL は変わらないので、毎回 ETag で確かめさせる
+		w.Header().Set("Cache-Control", "public, no-cache")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if ct == storeimage.PlaceholderContentType {
+			// 仮の表紙の SVG を直接開かれてもスクリプトなどは動かさない
+			w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'")
+		}
+		// If-None-Match, If-Modified-Since, Range は ServeContent が処理する
+		http.ServeContent(w, r, path.Base(name), image.ModTime, image)
+	})
+}
+
+// openImage は ?size= が指定された場合は縮小画像を、無い場合や縮小画像が無い場合は元の画像を開く
+// 形式は ?format= (webp, jpeg) か Accept で決める
+func (s *BooksService) openImage(w http.ResponseWriter, r *http.Request, name string) (*storecommon.Object, error) {
+	query := r.URL.Query()
+	if query.Get("size") == "" {
+		return s.store.OpenImage(name)
+	}
+	size, err := strconv.Atoi(query.Get("size"))
+	if err != nil || size <= 0 {
+		return s.store.OpenImage(name)
+	}
+
+	format := query.Get("format")
+	if storeimage.ContentType(format) == "" {
+		w.Header().Add("Vary", "Accept")
+		format = storeimage.JPEG
+		if strings.Contains(r.Header.Get("Accept"), "image/webp") {
+			format = storeimage.WebP
+		}
+	}
+
+	variant := storeimage.VariantName(variantSize(size), format)
+	image, err := s.store.OpenImage(storecommon.VariantKey(name, variant))
+	if errors.Is(err, storecommon.ErrNotFoundImage) {
+		return s.store.OpenImage(name)
+	}
+	return image, err
+}
+
+// variantSize は size 以上で最も小さい縮小画像の大きさを返す (無い場合は最も大きいもの)
+func variantSize(size int) int {
+	for _, s := range storeimage.Sizes {
+		if s >= size {
+			return s
+		}
+	}
+	return storeimage.Sizes[len(storeimage.Sizes)-1]
+}
+
+// imageURL は Image.Path から画像の完全な URL を作る
+func (s *BooksService) imageURL(image bookscommon.Image) string {
+	return s.pathURL(image.Path)
+}
+
+// pathURL は ObjectStore の画像のキーから完全な URL を作る
+// 署名付き URL のように既に完全な URL の場合はそのまま返す
+func (s *BooksService) pathURL(path string) string {
+	if path == "" {
+		return ""
+	}
+	if u, err := url.Parse(path); err == nil && u.IsAbs() {
+		return path
+	}
+	return s.publicURL + "/images/" + (&url.URL{Path: path}).EscapedPath()
+}
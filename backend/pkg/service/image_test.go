@@ -0,0 +1,78 @@
+package service
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nyahahanoha/BookManagementSystem/backend/pkg/store"
+	storeconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/config"
+	storefetch "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/fetch"
+	filestore "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/object/file"
+)
+
+// 表紙を差し替えても URL は同じなので、キャッシュは ETag で確かめ直させる
+func TestImageHandlerReplacedCover(t *testing.T) {
+	lg := slog.New(slog.NewTextHandler(io.Discard, nil))
+	object, err := filestore.NewFileStore(lg, storeconfig.FileConfig{Prefix: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+	s := &BooksService{
+		lg:        lg,
+		store:     *store.New(lg, &fakeDB{}, object, storefetch.NewFetcher(lg, storeconfig.FetchConfig{})),
+		publicURL: "https://books.example.com",
+	}
+	handler := s.ImageHandler()
+
+	get := func(t *testing.T, key, etag string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/images/"+key, nil)
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if err := object.Upload("book1", bytes.NewReader([]byte("old cover")), "image/png"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	key, err := object.Get("book1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	first := get(t, key, "")
+	if first.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", first.Code, http.StatusOK)
+	}
+	if got := first.Header().Get("Cache-Control"); got != "public, no-cache" {
+		t.Errorf("Cache-Control = %q, want %q", got, "public, no-cache")
+	}
+	etag := first.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("ETag is empty")
+	}
+
+	if rec := get(t, key, etag); rec.Code != http.StatusNotModified {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotModified)
+	}
+
+	if err := object.Upload("book1", bytes.NewReader([]byte("new cover")), "image/png"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	rec := get(t, key, etag)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec.Body.String() != "new cover" {
+		t.Errorf("body = %q, want %q", rec.Body.String(), "new cover")
+	}
+	if rec.Header().Get("ETag") == etag {
+		t.Errorf("ETag = %q, want a new one", etag)
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+
+	book_management_systemv1 "github.com/nyahahanoha/BookManagementSystem/backend/api/book_management_system/v1"
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+)
+
+// convertLanguagesFromProtobuf は languages を、無ければ古い language を変換する
+// どちらも無い場合はタイトルの文字から推測する
+func convertLanguagesFromProtobuf(book *book_management_systemv1.Book) ([]bookscommon.Language, error) {
+	var languages []bookscommon.Language
+	for _, code := range book.Languages {
+		language := bookscommon.ParseLanguage(code)
+		if language == "" {
+			return nil, fmt.Errorf("invalid language: %q", code)
+		}
+		if !slices.Contains(languages, language) {
+			languages = append(languages, language)
+		}
+	}
+	if len(languages) > 0 {
+		return languages, nil
+	}
+
+	switch book.Language {
+	case book_management_systemv1.Language_JAPANESE:
+		return []bookscommon.Language{bookscommon.Japanese}, nil
+	case book_management_systemv1.Language_ENGLISH:
+		return []bookscommon.Language{bookscommon.English}, nil
+	}
+	if language := bookscommon.DetectLanguage(book.Title); language != "" {
+		return []bookscommon.Language{language}, nil
+	}
+	return nil, nil
+}
+
+func convertLanguagesToProtobuf(languages []bookscommon.Language) []string {
+	codes := make([]string, 0, len(languages))
+	for _, language := range languages {
+		codes = append(codes, string(language))
+	}
+	return codes
+}
+
+// convertLanguageToProtobuf は古いクライアントのために先頭の言語を Language にする
+func convertLanguageToProtobuf(languages []bookscommon.Language) book_management_systemv1.Language {
+	if len(languages) == 0 {
+		return book_management_systemv1.Language_UNKNOWN
+	}
+	switch languages[0] {
+	case bookscommon.Japanese:
+		return book_management_systemv1.Language_JAPANESE
+	case bookscommon.English:
+		return book_management_systemv1.Language_ENGLISH
+	default:
+		return book_management_systemv1.Language_UNKNOWN
+	}
+}
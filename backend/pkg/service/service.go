@@ -2,13 +2,20 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
 
 	"connectrpc.com/connect"
 	book_management_systemv1 "github.com/nyahahanoha/BookManagementSystem/backend/api/book_management_system/v1"
 	"github.com/nyahahanoha/BookManagementSystem/backend/pkg/books"
+	bookscache "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/cache"
 	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksmerge "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/merge"
 	"github.com/nyahahanoha/BookManagementSystem/backend/pkg/config"
 	"github.com/nyahahanoha/BookManagementSystem/backend/pkg/store"
 )
@@ -16,25 +23,51 @@ import (
 type BooksService struct {
 	lg *slog.Logger
 
-	books []books.Books
-	store store.BookStore
+	books  []books.Provider
+	merger *booksmerge.Merger
+	store  store.BookStore
+
+	// 画像の URL は publicURL/images/ 以下になる
+	publicURL string
 }
 
 func NewBooksService(lg *slog.Logger, config config.Config) (*BooksService, error) {
-	books, err := books.NewBooks(config.BooksConfig)
+	books, err := books.NewBooks(lg, config.BooksConfig, nil)
 	if err != nil {
 		return nil, fmt.Errorf("faild to create books: %w", err)
 	}
 
+	merger, err := booksmerge.NewMerger(config.BooksConfig.Merge)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create merger: %w", err)
+	}
+
+	publicURL, err := url.Parse(config.PublicURL)
+	if err != nil || !publicURL.IsAbs() {
+		return nil, fmt.Errorf("public_url must be an absolute url: %q", config.PublicURL)
+	}
+
 	store, err := store.NewBooksStore(lg, config.StoreConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create store: %w", err)
 	}
 
+	if config.BooksConfig.Cache.Enabled {
+		for i, b := range books {
+			newBooks := func(wrap func(http.RoundTripper) http.RoundTripper) (bookscache.Books, error) {
+				return b.New(wrap)
+			}
+			books[i].Books = bookscache.NewCachedBooks(lg, config.BooksConfig.Cache, b.Name, b.Books, newBooks, store)
+		}
+	}
+
 	return &BooksService{
-		lg:    lg.With(slog.String("Package", "service")),
-		books: books,
-		store: *store,
+		lg:     lg.With(slog.String("Package", "service")),
+		books:  books,
+		merger: merger,
+		store:  *store,
+
+		publicURL: strings.TrimSuffix(publicURL.String(), "/"),
 	}, nil
 }
 
@@ -67,87 +100,136 @@ func NewAuthorizationInterceptor(token string, lg *slog.Logger) connect.UnaryInt
 }
 
 func (s *BooksService) PutBook(ctx context.Context, req *connect.Request[book_management_systemv1.PutBookRequest]) (*connect.Response[book_management_systemv1.PutBookResponse], error) {
-	s.lg.Info("recieved request to Put book", slog.String("isbn", req.Msg.Isbn))
-	var info *bookscommon.Info
-	info, err := s.books[0].GetInfo(req.Msg.Isbn)
+	// isbn だけの古いリクエストも identifier と同じように表記を揃える
+	msgIdent := req.Msg.Identifier
+	if msgIdent == nil {
+		msgIdent = &book_management_systemv1.Identifier{Type: book_management_systemv1.IdentifierType_ISBN, Value: req.Msg.Isbn}
+	}
+	ident, err := convertIdentifierFromProtobuf(msgIdent)
 	if err != nil {
-		s.lg.Error("failed to get info", slog.String("err", err.Error()))
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
+	s.lg.Info("recieved request to Put book", slog.String("type", ident.Type.String()), slog.String("value", ident.Value))
 
-	for _, b := range s.books[1:] {
-		moreInfo, err := b.GetInfo(req.Msg.Isbn)
-		if err != nil {
-			s.lg.Warn("failed to get info from another source", slog.String("err", err.Error()))
+	var results []booksmerge.Result
+	for _, b := range s.books {
+		var info *bookscommon.Info
+		var err error
+		if ident.Type == bookscommon.ISBN {
+			info, err = b.GetInfo(ident.Value)
+		} else if lookup, ok := b.Books.(books.IdentifierLookup); ok {
+			info, err = lookup.GetInfoByIdentifier(ident)
+		} else {
 			continue
 		}
-		if info == nil {
-			info = moreInfo
-		}
-		if info.Description == bookscommon.NoDescription && moreInfo.Description != bookscommon.NoDescription || info.Description == "" {
-			info.Description = moreInfo.Description
-		}
-		if info.Image.Source.String() == "" && moreInfo.Image.Source.String() != "" {
-			info.Image = moreInfo.Image
-		}
-		if info.Language == bookscommon.UNKOWN && moreInfo.Language != bookscommon.UNKOWN {
-			info.Language = moreInfo.Language
-		}
-		if info.Publishdate.IsZero() && !moreInfo.Publishdate.IsZero() {
-			info.Publishdate = moreInfo.Publishdate
+		if errors.Is(err, bookscommon.ErrNotSupported) {
+			continue
+		} else if err != nil {
+			s.lg.Warn("failed to get info", slog.String("source", b.Name), slog.String("err", err.Error()))
+			continue
 		}
-		if len(info.Authors) == 0 && len(moreInfo.Authors) != 0 {
-			info.Authors = moreInfo.Authors
+		// 表紙の候補にプロバイダーの名前を付ける (候補を返さないプロバイダーは Image を候補にする)
+		if len(info.Covers) == 0 && info.Image.Source.String() != "" {
+			info.Covers = []bookscommon.CoverCandidate{{URL: info.Image.Source}}
 		}
-		if info.Title == "" && moreInfo.Title != "" {
-			info.Title = moreInfo.Title
+		for i := range info.Covers {
+			info.Covers[i].Source = b.Name
 		}
+		results = append(results, booksmerge.Result{
+			Kind: b.Kind,
+			Info: info,
+		})
+	}
+	var isbn string
+	if ident.Type == bookscommon.ISBN {
+		isbn = ident.Value
+	}
+	info, err := s.merger.Merge(isbn, results)
+	if err != nil {
+		s.lg.Error("failed to get info", slog.String("err", err.Error()))
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to get info: %w", err))
+	}
+	if !slices.Contains(info.Identifiers, ident) {
+		info.Identifiers = append(info.Identifiers, ident)
 	}
 	if info.Title == "" {
-		info.Title = req.Msg.Isbn
+		info.Title = ident.Value
+	}
+	info.ID, err = s.bookID(*info)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to get book id: %w", err)
 	}
-	s.lg.Info("Get book info", slog.String("title", info.Title), slog.String("isbn", info.ISBN))
+	s.lg.Info("Get book info", slog.String("title", info.Title), slog.String("id", info.ID))
 	if err := s.store.Put(*info); err != nil {
 		s.lg.Error("failed to put info in store", slog.String("err", err.Error()))
 		return nil, fmt.Errorf("failed to put info in store: %w", err)
 	}
-	book := convertInfoToProtobuf(*info)
-	book.Imageurl = info.Image.Source.String()
+	info.Image.Path, err = s.store.ImagePath(info.ID)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to get image path: %w", err)
+	}
+	book := s.convertInfoToProtobuf(*info)
 	return connect.NewResponse(&book_management_systemv1.PutBookResponse{
 		Book: book,
 	}), nil
 }
 
-func convertInfoToProtobuf(info bookscommon.Info) *book_management_systemv1.Book {
-	var language book_management_systemv1.Language
-	switch info.Language {
-	case bookscommon.JP:
-		language = book_management_systemv1.Language_JAPANESE
-	case bookscommon.EN:
-		language = book_management_systemv1.Language_ENGLISH
-	default:
-		language = book_management_systemv1.Language_UNKNOWN
+func (s *BooksService) convertInfoToProtobuf(info bookscommon.Info) *book_management_systemv1.Book {
+	identifiers := make([]*book_management_systemv1.Identifier, 0, len(info.Identifiers))
+	for _, id := range info.Identifiers {
+		identifiers = append(identifiers, convertIdentifierToProtobuf(id))
+	}
+	contributors := make([]*book_management_systemv1.Contributor, 0, len(info.Contributors))
+	for _, c := range info.Contributors {
+		contributors = append(contributors, convertContributorToProtobuf(c))
 	}
 	return &book_management_systemv1.Book{
-		Isbn:        info.ISBN,
-		Title:       info.Title,
-		Authors:     info.Authors,
-		Description: info.Description,
-		Publishdate: info.Publishdate.Format("2006-01"),
-		Language:    language,
-		Imageurl:    info.Image.Path,
+		Id:                   info.ID,
+		Identifiers:          identifiers,
+		Isbn:                 info.ISBN,
+		Title:                info.Title,
+		TitleReading:         info.TitleReading,
+		Authors:              info.AuthorNames(),
+		Contributors:         contributors,
+		Description:          info.Description,
+		Publishdate:          info.Publishdate.String(),
+		PublishdatePrecision: convertDatePrecisionToProtobuf(info.Publishdate.Precision),
+		Language:             convertLanguageToProtobuf(info.Languages),
+		Languages:            convertLanguagesToProtobuf(info.Languages),
+		Imageurl:             s.imageURL(info.Image),
+		ImageColor:           info.Image.Color,
+		ImageBlurhash:        info.Image.Blurhash,
+		ImageOrigin:          convertImageOriginToProtobuf(info.Image.Origin),
+		Publisher:            info.Publisher,
+		Pages:                int32(info.Pages),
+		Subjects:             info.Subjects,
+		Edition:              info.Edition,
+		Ndc:                  info.NDC,
+		Series:               info.Series,
+		Price: &book_management_systemv1.Price{
+			Amount:   info.Price.Amount,
+			Currency: info.Price.Currency,
+		},
 	}
 }
 
 func (s *BooksService) GetBook(ctx context.Context, req *connect.Request[book_management_systemv1.GetBookRequest]) (*connect.Response[book_management_systemv1.GetBookResponse], error) {
-	s.lg.Info("recieved request to Get book", slog.String("isbn", req.Msg.Isbn))
-	info, err := s.store.Get(req.Msg.Isbn)
+	s.lg.Info("recieved request to Get book", slog.String("isbn", req.Msg.Isbn), slog.String("id", req.Msg.Id))
+	id, err := s.resolveBookID(req.Msg.Id, req.Msg.Isbn)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to resolve book: %w", err)
+	}
+	info, err := s.store.Get(id)
 	if err != nil {
 		s.lg.Error("internal server error", slog.String("err", err.Error()))
 		return nil, fmt.Errorf("failed to get book in store: %w", err)
 	}
 
 	return connect.NewResponse(&book_management_systemv1.GetBookResponse{
-		Book: convertInfoToProtobuf(info),
+		Book: s.convertInfoToProtobuf(info),
 	}), nil
 }
 
@@ -163,7 +245,7 @@ func (s *BooksService) GetAllBooks(ctx context.Context, req *connect.Request[boo
 		Books: func() []*book_management_systemv1.Book {
 			res := make([]*book_management_systemv1.Book, 0, len(books))
 			for _, info := range books {
-				res = append(res, convertInfoToProtobuf(info))
+				res = append(res, s.convertInfoToProtobuf(info))
 			}
 			return res
 		}(),
@@ -171,8 +253,12 @@ func (s *BooksService) GetAllBooks(ctx context.Context, req *connect.Request[boo
 }
 
 func (s *BooksService) SearchBook(ctx context.Context, req *connect.Request[book_management_systemv1.SearchBookRequest]) (*connect.Response[book_management_systemv1.SearchBookResponse], error) {
-	s.lg.Info("recieved request to Search books", slog.String("title", req.Msg.Title))
-	books, err := s.store.Search(req.Msg.Title)
+	s.lg.Info("recieved request to Search books", slog.String("title", req.Msg.Title), slog.String("publisher", req.Msg.Publisher), slog.String("subject", req.Msg.Subject))
+	books, err := s.store.Search(bookscommon.Filter{
+		Title:     req.Msg.Title,
+		Publisher: req.Msg.Publisher,
+		Subject:   req.Msg.Subject,
+	})
 	if err != nil {
 		s.lg.Error("internal server error", slog.String("err", err.Error()))
 		return nil, fmt.Errorf("failed to search books in store: %w", err)
@@ -181,7 +267,7 @@ func (s *BooksService) SearchBook(ctx context.Context, req *connect.Request[book
 		Books: func() []*book_management_systemv1.Book {
 			res := make([]*book_management_systemv1.Book, 0, len(books))
 			for _, info := range books {
-				res = append(res, convertInfoToProtobuf(info))
+				res = append(res, s.convertInfoToProtobuf(info))
 			}
 			return res
 		}(),
@@ -189,8 +275,13 @@ func (s *BooksService) SearchBook(ctx context.Context, req *connect.Request[book
 }
 
 func (s *BooksService) DeleteBook(ctx context.Context, req *connect.Request[book_management_systemv1.DeleteBookRequest]) (*connect.Response[book_management_systemv1.DeleteBookResponse], error) {
-	s.lg.Info("recieved request to Delete book", slog.String("isbn", req.Msg.Isbn))
-	if err := s.store.Del(req.Msg.Isbn); err != nil {
+	s.lg.Info("recieved request to Delete book", slog.String("isbn", req.Msg.Isbn), slog.String("id", req.Msg.Id))
+	id, err := s.resolveBookID(req.Msg.Id, req.Msg.Isbn)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to resolve book: %w", err)
+	}
+	if err := s.store.Del(id); err != nil {
 		s.lg.Error("internal server error", slog.String("err", err.Error()))
 		return nil, fmt.Errorf("failed to delete book in store: %w", err)
 	}
@@ -198,8 +289,13 @@ func (s *BooksService) DeleteBook(ctx context.Context, req *connect.Request[book
 }
 
 func (s *BooksService) RenameBook(ctx context.Context, req *connect.Request[book_management_systemv1.RenameBookRequest]) (*connect.Response[book_management_systemv1.RenameBookResponse], error) {
-	s.lg.Info("recieved request to Rename book", slog.String("isbn", req.Msg.Isbn), slog.String("title", req.Msg.Title))
-	if err := s.store.Rename(req.Msg.Isbn, req.Msg.Title); err != nil {
+	s.lg.Info("recieved request to Rename book", slog.String("isbn", req.Msg.Isbn), slog.String("id", req.Msg.Id), slog.String("title", req.Msg.Title))
+	id, err := s.resolveBookID(req.Msg.Id, req.Msg.Isbn)
+	if err != nil {
+		s.lg.Error("internal server error", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("failed to resolve book: %w", err)
+	}
+	if err := s.store.Rename(id, req.Msg.Title); err != nil {
 		s.lg.Error("internal server error", slog.String("err", err.Error()))
 		return nil, fmt.Errorf("failed to rename book in store: %w", err)
 	}
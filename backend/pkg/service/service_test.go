@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"reflect"
+	"testing"
+
+	"connectrpc.com/connect"
+	book_management_systemv1 "github.com/nyahahanoha/BookManagementSystem/backend/api/book_management_system/v1"
+	"github.com/nyahahanoha/BookManagementSystem/backend/pkg/books"
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	booksconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/config"
+	booksmerge "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/merge"
+	"github.com/nyahahanoha/BookManagementSystem/backend/pkg/store"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+	storeconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/config"
+	"github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/db"
+	storefetch "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/fetch"
+	filestore "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/object/file"
+)
+
+// fakeDB は PutBook が使うメソッドだけを持つ DBStore
+type fakeDB struct {
+	db.DBStore
+	books map[string]bookscommon.Info
+}
+
+func (d *fakeDB) Get(id string) (bookscommon.Info, error) {
+	info, ok := d.books[id]
+	if !ok {
+		return bookscommon.Info{}, storecommon.ErrNotFoundBook
+	}
+	return info, nil
+}
+
+func (d *fakeDB) Put(book bookscommon.Info) error {
+	d.books[book.ID] = book
+	return nil
+}
+
+func (d *fakeDB) Resolve(id bookscommon.Identifier) (string, error) {
+	for bookID, info := range d.books {
+		for _, i := range info.Identifiers {
+			if i == id {
+				return bookID, nil
+			}
+		}
+	}
+	return "", storecommon.ErrNotFoundBook
+}
+
+func (d *fakeDB) PutImageMeta(id string, image bookscommon.Image) error {
+	info := d.books[id]
+	info.Image.Color, info.Image.Blurhash, info.Image.Origin = image.Color, image.Blurhash, image.Origin
+	d.books[id] = info
+	return nil
+}
+
+// newTestService は testdata に記録したプロバイダーのレスポンスを再生する BooksService を作る
+// OpenLibrary のレスポンスは記録していないので失敗したプロバイダーとして扱われる
+func newTestService(t *testing.T, merge booksconfig.MergeConfig) (*BooksService, *fakeDB) {
+	t.Helper()
+	lg := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	providers, err := books.NewBooks(lg, booksconfig.Config{
+		Kind:    []booksconfig.BooksComponent{booksconfig.Google, booksconfig.NDL, booksconfig.OpenLibrary, booksconfig.OpenBD},
+		Fixture: booksconfig.FixtureConfig{Mode: booksconfig.Replay, Dir: "testdata"},
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewBooks() error = %v", err)
+	}
+	merger, err := booksmerge.NewMerger(merge)
+	if err != nil {
+		t.Fatalf("NewMerger() error = %v", err)
+	}
+	object, err := filestore.NewFileStore(lg, storeconfig.FileConfig{Prefix: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+
+	db := &fakeDB{books: make(map[string]bookscommon.Info)}
+	return &BooksService{
+		lg:        lg,
+		books:     providers,
+		merger:    merger,
+		store:     *store.New(lg, db, object, storefetch.NewFetcher(lg, storeconfig.FetchConfig{})),
+		publicURL: "https://books.example.com",
+	}, db
+}
+
+func TestPutBookMerge(t *testing.T) {
+	s, db := newTestService(t, booksconfig.MergeConfig{
+		Title:       booksconfig.FieldPolicy{Priority: []booksconfig.BooksComponent{booksconfig.OpenBD}},
+		Authors:     booksconfig.FieldPolicy{Priority: []booksconfig.BooksComponent{booksconfig.OpenBD}},
+		Description: booksconfig.FieldPolicy{Strategy: booksconfig.Longest},
+		Price:       booksconfig.FieldPolicy{Priority: []booksconfig.BooksComponent{booksconfig.NDL}},
+		// 表紙を取得しに行かないようにする
+		Image: booksconfig.FieldPolicy{Strategy: booksconfig.None},
+	})
+
+	resp, err := s.PutBook(context.Background(), connect.NewRequest(&book_management_systemv1.PutBookRequest{Isbn: "9784101010137"}))
+	if err != nil {
+		t.Fatalf("PutBook() error = %v", err)
+	}
+	if resp.Msg.Book.Id != "9784101010137" {
+		t.Errorf("Id = %q, want %q", resp.Msg.Book.Id, "9784101010137")
+	}
+
+	info, ok := db.books["9784101010137"]
+	if !ok {
+		t.Fatal("book is not stored")
+	}
+
+	// 書名と読みは openBD から一緒に取る
+	if info.Title != "こころ" || info.TitleReading != "ココロ" {
+		t.Errorf("Title = %q (%q), want %q (%q)", info.Title, info.TitleReading, "こころ", "ココロ")
+	}
+	wantContributors := []bookscommon.Contributor{{Name: "夏目 漱石", Reading: "ナツメ ソウセキ", Role: bookscommon.Author}}
+	if !reflect.DeepEqual(info.Contributors, wantContributors) {
+		t.Errorf("Contributors = %+v, want %+v", info.Contributors, wantContributors)
+	}
+	if want := "親友を裏切って恋人を得たが、親友が自殺したために罪悪感に苦しみ、自らも死を選ぶ孤独な明治の知識人の内面を描いた作品。"; info.Description != want {
+		t.Errorf("Description = %q, want %q", info.Description, want)
+	}
+	if want := (bookscommon.Price{Amount: 400, Currency: "JPY"}); info.Price != want {
+		t.Errorf("Price = %+v, want %+v", info.Price, want)
+	}
+	// 既定では設定順に最初に値を返したプロバイダーを使う
+	if info.Pages != 384 {
+		t.Errorf("Pages = %d, want 384", info.Pages)
+	}
+	// NDL しか返さない項目
+	if info.NDC != "913.6" || info.Series != "新潮文庫" || info.Edition != "改版" {
+		t.Errorf("NDC, Series, Edition = %q, %q, %q, want %q, %q, %q", info.NDC, info.Series, info.Edition, "913.6", "新潮文庫", "改版")
+	}
+
+	wantIdentifiers := []bookscommon.Identifier{
+		{Type: bookscommon.ISBN, Value: "9784101010137"},
+		{Type: bookscommon.JPNO, Value: "20563321"},
+		{Type: bookscommon.NCID, Value: "BA66564521"},
+	}
+	if !reflect.DeepEqual(info.Identifiers, wantIdentifiers) {
+		t.Errorf("Identifiers = %+v, want %+v", info.Identifiers, wantIdentifiers)
+	}
+
+	// 表紙は使わなくても候補には全てのプロバイダーのものを残す
+	if info.Image.Source.String() != "" {
+		t.Errorf("Image.Source = %q, want empty", info.Image.Source.String())
+	}
+	var sources []string
+	for _, c := range info.Covers {
+		sources = append(sources, c.Source)
+	}
+	if want := []string{"Google", "Google", "Google", "Google", "Google", "NDL", "OpenBD"}; !reflect.DeepEqual(sources, want) {
+		t.Errorf("Covers sources = %v, want %v", sources, want)
+	}
+	// 表紙が無いので仮の表紙を作る
+	if info.Image.Origin != bookscommon.ImageGenerated {
+		t.Errorf("Image.Origin = %v, want %v", info.Image.Origin, bookscommon.ImageGenerated)
+	}
+}
+
+// isbn だけの古いリクエストも表記を揃えてから探す
+func TestPutBookLegacyISBN(t *testing.T) {
+	s, db := newTestService(t, booksconfig.MergeConfig{Image: booksconfig.FieldPolicy{Strategy: booksconfig.None}})
+
+	resp, err := s.PutBook(context.Background(), connect.NewRequest(&book_management_systemv1.PutBookRequest{Isbn: "978-4-10-101013-7"}))
+	if err != nil {
+		t.Fatalf("PutBook() error = %v", err)
+	}
+	if resp.Msg.Book.Id != "9784101010137" {
+		t.Errorf("Id = %q, want %q", resp.Msg.Book.Id, "9784101010137")
+	}
+	if _, ok := db.books["9784101010137"]; !ok {
+		t.Error("book is not stored under the normalized ISBN")
+	}
+
+	// チェックディジットの合わない ISBN はプロバイダーに問い合わせない
+	_, err = s.PutBook(context.Background(), connect.NewRequest(&book_management_systemv1.PutBookRequest{Isbn: "9784101010130"}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("PutBook() error = %v, want code %v", err, connect.CodeInvalidArgument)
+	}
+}
+
+func TestPutBookNotFound(t *testing.T) {
+	s, db := newTestService(t, booksconfig.MergeConfig{})
+
+	_, err := s.PutBook(context.Background(), connect.NewRequest(&book_management_systemv1.PutBookRequest{Isbn: "9780000000002"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("PutBook() error = %v, want code %v", err, connect.CodeNotFound)
+	}
+	if len(db.books) != 0 {
+		t.Errorf("stored %d books, want 0", len(db.books))
+	}
+}
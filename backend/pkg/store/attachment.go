@@ -0,0 +1,117 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+	storeimage "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/image"
+)
+
+// attachmentObjectID は本に付けた写真を ObjectStore に保存するときの id
+// 表紙と同じく縮小画像を派生画像として持つ
+func attachmentObjectID(bookID, id string) string {
+	return bookID + "_" + id
+}
+
+// AddAttachment は data の画像を attachment.BookID の本の写真の最後に加える
+// ID と大きさはここで決める
+func (s *BookStore) AddAttachment(attachment bookscommon.Attachment, data []byte, contentType string) (bookscommon.Attachment, error) {
+	var err error
+	attachment.ID = uuid.NewString()
+	attachment.Width, attachment.Height, err = storeimage.Size(data)
+	if err != nil {
+		return bookscommon.Attachment{}, fmt.Errorf("failed to get image size: %w", err)
+	}
+
+	objectID := attachmentObjectID(attachment.BookID, attachment.ID)
+	if err := s.object.Upload(objectID, bytes.NewReader(data), contentType); err != nil {
+		return bookscommon.Attachment{}, fmt.Errorf("failed to upload image in object: %w", err)
+	}
+	if err := s.db.PutAttachment(attachment); err != nil {
+		if err := s.object.Delete(objectID); err != nil {
+			s.lg.Error("failed to delete image", slog.String("id", objectID), slog.String("err", err.Error()))
+		}
+		return bookscommon.Attachment{}, fmt.Errorf("failed to put attachment in db: %w", err)
+	}
+	if err := s.processAttachment(objectID); err != nil {
+		// 縮小画像が無くても元の画像は返せるので失敗にはしない
+		s.lg.Warn("failed to process attachment", slog.String("id", objectID), slog.String("err", err.Error()))
+	}
+	return s.GetAttachment(attachment.BookID, attachment.ID)
+}
+
+// processAttachment は写真の縮小画像を作る
+func (s *BookStore) processAttachment(objectID string) error {
+	key, err := s.object.Get(objectID)
+	if err != nil {
+		return fmt.Errorf("failed to get image in object: %w", err)
+	}
+	if key == "" {
+		return nil
+	}
+	_, err = s.putVariants(objectID, key)
+	return err
+}
+
+func (s *BookStore) GetAttachments(bookID string) ([]bookscommon.Attachment, error) {
+	attachments, err := s.db.GetAttachments(bookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments in db: %w", err)
+	}
+	for i, attachment := range attachments {
+		path, err := s.ImagePath(attachmentObjectID(bookID, attachment.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get image path: %w", err)
+		}
+		attachments[i].Path = path
+	}
+	return attachments, nil
+}
+
+func (s *BookStore) GetAttachment(bookID, id string) (bookscommon.Attachment, error) {
+	attachment, err := s.db.GetAttachment(bookID, id)
+	if errors.Is(err, storecommon.ErrNotFoundAttachment) {
+		return bookscommon.Attachment{}, err
+	} else if err != nil {
+		return bookscommon.Attachment{}, fmt.Errorf("failed to get attachment in db: %w", err)
+	}
+	attachment.Path, err = s.ImagePath(attachmentObjectID(bookID, id))
+	if err != nil {
+		return bookscommon.Attachment{}, fmt.Errorf("failed to get image path: %w", err)
+	}
+	return attachment, nil
+}
+
+// DeleteAttachment は本の写真を消す
+// 画像が消せなくても DB からは消し、残った画像は fsck で消す
+func (s *BookStore) DeleteAttachment(bookID, id string) error {
+	if err := s.db.DeleteAttachment(bookID, id); errors.Is(err, storecommon.ErrNotFoundAttachment) {
+		return err
+	} else if err != nil {
+		return fmt.Errorf("failed to delete attachment in db: %w", err)
+	}
+	s.deleteAttachmentImage(bookID, id)
+	return nil
+}
+
+func (s *BookStore) deleteAttachmentImage(bookID, id string) {
+	objectID := attachmentObjectID(bookID, id)
+	if err := s.object.Delete(objectID); err != nil {
+		s.lg.Warn("failed to delete image in object", slog.String("id", objectID), slog.String("err", err.Error()))
+	}
+}
+
+// ReorderAttachments は本の写真を ids の順に並べ直す
+func (s *BookStore) ReorderAttachments(bookID string, ids []string) error {
+	if err := s.db.ReorderAttachments(bookID, ids); errors.Is(err, storecommon.ErrAttachmentOrder) {
+		return err
+	} else if err != nil {
+		return fmt.Errorf("failed to reorder attachments in db: %w", err)
+	}
+	return nil
+}
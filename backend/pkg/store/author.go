@@ -0,0 +1,66 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+)
+
+func (s *BookStore) ResolveAuthor(name string) (int64, error) {
+	id, err := s.db.ResolveAuthor(name)
+	if errors.Is(err, storecommon.ErrNotFoundAuthor) {
+		return 0, err
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to resolve author in db: %w", err)
+	}
+	return id, nil
+}
+
+func (s *BookStore) GetAuthor(id int64) (bookscommon.AuthorRecord, error) {
+	record, err := s.db.GetAuthor(id)
+	if errors.Is(err, storecommon.ErrNotFoundAuthor) {
+		return bookscommon.AuthorRecord{}, err
+	} else if err != nil {
+		return bookscommon.AuthorRecord{}, fmt.Errorf("failed to get author in db: %w", err)
+	}
+	return record, nil
+}
+
+func (s *BookStore) ListAuthors(query string) ([]bookscommon.AuthorRecord, error) {
+	records, err := s.db.ListAuthors(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list authors in db: %w", err)
+	}
+	return records, nil
+}
+
+func (s *BookStore) UpdateAuthor(record bookscommon.AuthorRecord) error {
+	if err := s.db.UpdateAuthor(record); err != nil {
+		return fmt.Errorf("failed to update author in db: %w", err)
+	}
+	return nil
+}
+
+func (s *BookStore) MergeAuthors(target int64, sources []int64) error {
+	if err := s.db.MergeAuthors(target, sources); err != nil {
+		return fmt.Errorf("failed to merge authors in db: %w", err)
+	}
+	return nil
+}
+
+func (s *BookStore) GetBooksByAuthor(authorID int64) ([]bookscommon.Info, error) {
+	books, err := s.db.GetBooksByAuthor(authorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get info in db: %w", err)
+	}
+	for i, book := range books {
+		path, err := s.ImagePath(book.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get image path: %w", err)
+		}
+		books[i].Image.Path = path
+	}
+	return books, nil
+}
@@ -3,3 +3,10 @@ package storecommon
 import "fmt"
 
 var ErrNotFoundBook = fmt.Errorf("not found book")
+var ErrNotFoundCache = fmt.Errorf("not found cache")
+var ErrNotFoundAuthor = fmt.Errorf("not found author")
+var ErrAliasConflict = fmt.Errorf("alias conflicts with another author")
+var ErrNotFoundImage = fmt.Errorf("not found image")
+var ErrNotFoundCover = fmt.Errorf("not found cover candidate")
+var ErrNotFoundAttachment = fmt.Errorf("not found attachment")
+var ErrAttachmentOrder = fmt.Errorf("attachment ids do not match the book's attachments")
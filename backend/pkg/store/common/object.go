@@ -0,0 +1,65 @@
+package storecommon
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Object は ObjectStore から開いた画像
+type Object struct {
+	io.ReadSeekCloser
+	ContentType string
+	ModTime     time.Time
+	// ETag は内容が変わると変わる値 (引用符は付けない)
+	ETag string
+}
+
+// VariantKey は画像のキーから縮小画像などの派生画像のキーを作る
+// "2e/9784101001565.jpeg" と "256.webp" なら "2e/9784101001565@256.webp"
+func VariantKey(key, variant string) string {
+	return strings.TrimSuffix(key, path.Ext(key)) + "@" + variant
+}
+
+// ImageExt は画像の Content-Type に対応する拡張子を返す (知らない形式は空)
+func ImageExt(contentType string) string {
+	switch contentType {
+	case "image/jpeg":
+		return ".jpeg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	case "image/svg+xml":
+		return ".svg"
+	default:
+		return ""
+	}
+}
+
+var (
+	idPattern      = regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_-]{0,127}$`)
+	variantPattern = regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_-]{0,31}(\.[0-9A-Za-z]{1,8})?$`)
+)
+
+// ValidateID は画像のキーに使う本の ID を検証する
+// ISBN や UUID を想定し、パスの区切りや "." などは受け付けない
+func ValidateID(id string) error {
+	if !idPattern.MatchString(id) {
+		return fmt.Errorf("invalid id: %q", id)
+	}
+	return nil
+}
+
+// ValidateVariant は派生画像の名前 ("256.webp" など) を検証する
+func ValidateVariant(variant string) error {
+	if !variantPattern.MatchString(variant) {
+		return fmt.Errorf("invalid variant: %q", variant)
+	}
+	return nil
+}
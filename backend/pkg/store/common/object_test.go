@@ -0,0 +1,67 @@
+package storecommon
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateID(t *testing.T) {
+	tests := []struct {
+		id      string
+		wantErr bool
+	}{
+		{"9784101001565", false},
+		{"2f1c7a4e-9b7d-4c55-8a53-5bb0f8e3c1d2", false},
+		{"9784101001565_2f1c7a4e-9b7d-4c55-8a53-5bb0f8e3c1d2", false},
+		{"", true},
+		{".", true},
+		{"..", true},
+		{"../etc/passwd", true},
+		{"..%2fetc", true},
+		{"a/b", true},
+		{`a\b`, true},
+		{"-rf", true},
+		{"_hidden", true},
+		{"9784101001565.jpeg", true},
+		{"9784101001565@256.webp", true},
+		{"a b", true},
+		{strings.Repeat("a", 128), false},
+		{strings.Repeat("a", 129), true},
+	}
+	for _, tt := range tests {
+		if err := ValidateID(tt.id); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateID(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+		}
+	}
+}
+
+func TestValidateVariant(t *testing.T) {
+	tests := []struct {
+		variant string
+		wantErr bool
+	}{
+		{"256.webp", false},
+		{"512.jpeg", false},
+		{"placeholder", false},
+		{"", true},
+		{".", true},
+		{"..", true},
+		{".webp", true},
+		{"../256.webp", true},
+		{"256.webp/..", true},
+		{"256..webp", true},
+		{"256.we/bp", true},
+		{"256.webp.jpeg", true},
+	}
+	for _, tt := range tests {
+		if err := ValidateVariant(tt.variant); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateVariant(%q) error = %v, wantErr %v", tt.variant, err, tt.wantErr)
+		}
+	}
+}
+
+func TestVariantKey(t *testing.T) {
+	if got, want := VariantKey("2e/9784101001565.jpeg", "256.webp"), "2e/9784101001565@256.webp"; got != want {
+		t.Errorf("VariantKey() = %q, want %q", got, want)
+	}
+}
@@ -1,8 +1,22 @@
 package storeconfig
 
+import "time"
+
 type ObjectConfig struct {
 	Kind       ObjectComponent `yaml:"kind"`
 	FileConfig FileConfig      `yaml:"file"`
+	S3Config   S3Config        `yaml:"s3"`
+	Fetch      FetchConfig     `yaml:"fetch"`
+}
+
+// FetchConfig はプロバイダーが返した表紙の URL から画像を取得するときの制限
+type FetchConfig struct {
+	// AllowedHosts は既定のプロバイダーのホストに加えて取得を許すホスト (サブドメインも含む)
+	AllowedHosts []string `yaml:"allowed_hosts"`
+	// MaxBytes は画像の大きさの上限 (0 の場合は 10 MiB)
+	MaxBytes int64 `yaml:"max_bytes"`
+	// Timeout はリダイレクトも含めた取得全体の時間制限 (0 の場合は 15 秒)
+	Timeout time.Duration `yaml:"timeout"`
 }
 
 //go:generate go run github.com/dmarkham/enumer -type=ObjectComponent -yaml
@@ -10,8 +24,29 @@ type ObjectComponent uint32
 
 const (
 	FileSystem ObjectComponent = iota
+	// S3 は AWS S3 や MinIO、Cloudflare R2 などの S3 互換ストレージ
+	S3
 )
 
 type FileConfig struct {
 	Prefix string `yaml:"path"`
 }
+
+type S3Config struct {
+	// Endpoint はスキーム無しのホスト名 (AWS の場合は s3.amazonaws.com)
+	Endpoint string `yaml:"endpoint"`
+	Region   string `yaml:"region"`
+	Bucket   string `yaml:"bucket"`
+	// Prefix はオブジェクトのキーの前に付ける文字列 ("covers/" など)
+	Prefix          string `yaml:"prefix"`
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+	// Insecure は HTTP で接続する (ローカルの MinIO など)
+	Insecure bool `yaml:"insecure"`
+	// PathStyle はバケット名をホスト名ではなくパスに入れる (MinIO は通常こちら)
+	PathStyle bool `yaml:"path_style"`
+	// Presign は画像を API 経由で返さずに署名付き URL を返す
+	Presign bool `yaml:"presign"`
+	// PresignExpiry は署名付き URL の有効期間 (空の場合は 1 時間)
+	PresignExpiry time.Duration `yaml:"presign_expiry"`
+}
@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _ObjectComponentName = "FileSystem"
+const _ObjectComponentName = "FileSystemS3"
 
-var _ObjectComponentIndex = [...]uint8{0, 10}
+var _ObjectComponentIndex = [...]uint8{0, 10, 12}
 
-const _ObjectComponentLowerName = "filesystem"
+const _ObjectComponentLowerName = "filesystems3"
 
 func (i ObjectComponent) String() string {
 	if i >= ObjectComponent(len(_ObjectComponentIndex)-1) {
@@ -25,17 +25,21 @@ func (i ObjectComponent) String() string {
 func _ObjectComponentNoOp() {
 	var x [1]struct{}
 	_ = x[FileSystem-(0)]
+	_ = x[S3-(1)]
 }
 
-var _ObjectComponentValues = []ObjectComponent{FileSystem}
+var _ObjectComponentValues = []ObjectComponent{FileSystem, S3}
 
 var _ObjectComponentNameToValueMap = map[string]ObjectComponent{
-	_ObjectComponentName[0:10]:      FileSystem,
-	_ObjectComponentLowerName[0:10]: FileSystem,
+	_ObjectComponentName[0:10]:       FileSystem,
+	_ObjectComponentLowerName[0:10]:  FileSystem,
+	_ObjectComponentName[10:12]:      S3,
+	_ObjectComponentLowerName[10:12]: S3,
 }
 
 var _ObjectComponentNames = []string{
 	_ObjectComponentName[0:10],
+	_ObjectComponentName[10:12],
 }
 
 // ObjectComponentString retrieves an enum value from the enum constants string name.
@@ -0,0 +1,108 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"sync"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+	storeimage "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/image"
+)
+
+// measureConcurrency は CoverCandidates が同時に取得する候補の数
+const measureConcurrency = 3
+
+// CoverCandidates は id の本の表紙の候補を返す
+// まだ大きさを測っていない候補は取得して測り、記録しておく
+// ctx が終わると測るのをやめ、測れなかった候補は大きさを 0 のまま返す
+func (s *BookStore) CoverCandidates(ctx context.Context, id string) ([]bookscommon.CoverCandidate, error) {
+	candidates, err := s.db.GetCoverCandidates(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cover candidates in db: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, measureConcurrency)
+	for i := range candidates {
+		if candidates[i].Width > 0 {
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(candidate *bookscommon.CoverCandidate) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			image, err := s.fetcher.Fetch(ctx, candidate.URL)
+			if err != nil {
+				s.lg.Warn("failed to fetch cover candidate", slog.String("id", id), slog.String("url", candidate.URL.String()), slog.String("err", err.Error()))
+				return
+			}
+			candidate.Width, candidate.Height, err = storeimage.Size(image.Data)
+			if err != nil {
+				s.lg.Warn("failed to measure cover candidate", slog.String("id", id), slog.String("url", candidate.URL.String()), slog.String("err", err.Error()))
+				return
+			}
+			if err := s.db.PutCoverCandidateSize(id, candidate.URL.String(), candidate.Width, candidate.Height); err != nil {
+				s.lg.Warn("failed to put cover candidate size in db", slog.String("id", id), slog.String("err", err.Error()))
+			}
+		}(&candidates[i])
+	}
+	wg.Wait()
+	return candidates, nil
+}
+
+// SelectCover は表紙の候補の中から u の画像を id の本の表紙にする
+// 選んだ表紙はプロバイダーから取り直しても置き換えない
+func (s *BookStore) SelectCover(ctx context.Context, id string, u url.URL) error {
+	candidates, err := s.db.GetCoverCandidates(id)
+	if err != nil {
+		return fmt.Errorf("failed to get cover candidates in db: %w", err)
+	}
+	var found bool
+	for _, candidate := range candidates {
+		if candidate.URL.String() == u.String() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return storecommon.ErrNotFoundCover
+	}
+
+	image, err := s.fetcher.Fetch(ctx, u)
+	if err != nil {
+		return fmt.Errorf("failed to fetch cover: %w", err)
+	}
+	if width, height, err := storeimage.Size(image.Data); err == nil {
+		if err := s.db.PutCoverCandidateSize(id, u.String(), width, height); err != nil {
+			s.lg.Warn("failed to put cover candidate size in db", slog.String("id", id), slog.String("err", err.Error()))
+		}
+	}
+
+	backup, err := s.backupImage(id)
+	if err != nil {
+		return err
+	}
+	if err := s.object.Upload(id, bytes.NewReader(image.Data), image.ContentType); err != nil {
+		return fmt.Errorf("failed to put image in object: %w", err)
+	}
+	meta := bookscommon.Image{Source: u, Origin: bookscommon.ImageSelected}
+	if err := s.processImage(id, meta); err != nil {
+		s.lg.Warn("failed to process image", slog.String("id", id), slog.String("err", err.Error()))
+		if err := s.db.PutImageMeta(id, meta); err != nil {
+			s.restoreImage(id, backup)
+			return fmt.Errorf("failed to put image meta in db: %w", err)
+		}
+	}
+	return nil
+}
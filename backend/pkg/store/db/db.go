@@ -12,12 +12,44 @@ import (
 type DBStore interface {
 	Init() error
 	Put(book bookscommon.Info) error
-	Get(isbn string) (bookscommon.Info, error)
+	Get(id string) (bookscommon.Info, error)
+	Resolve(id bookscommon.Identifier) (string, error)
 	GetAll() ([]bookscommon.Info, error)
-	Search(title string) ([]bookscommon.Info, error)
-	Delete(isbn string) error
+	Search(filter bookscommon.Filter) ([]bookscommon.Info, error)
+	Delete(id string) error
 
-	Rename(isbn, title string) error
+	Rename(id, title string) error
+	// PutImageMeta は保存した表紙の代表色、blurhash、取得元を記録する
+	// image.Source が空でない場合は表紙の URL も書き換える
+	PutImageMeta(id string, image bookscommon.Image) error
+	// GetCoverCandidates は Put で保存した表紙の候補を返す
+	GetCoverCandidates(id string) ([]bookscommon.CoverCandidate, error)
+	// PutCoverCandidateSize は表紙の候補の大きさを記録する
+	PutCoverCandidateSize(id, url string, width, height int) error
+
+	// PutAttachment は写真を本の写真の最後に加える
+	PutAttachment(attachment bookscommon.Attachment) error
+	// GetAttachments は本の写真を Position の順に返す
+	GetAttachments(bookID string) ([]bookscommon.Attachment, error)
+	GetAttachment(bookID, id string) (bookscommon.Attachment, error)
+	DeleteAttachment(bookID, id string) error
+	// ReorderAttachments は本の写真を ids の順に並べ直す
+	ReorderAttachments(bookID string, ids []string) error
+
+	ResolveAuthor(name string) (int64, error)
+	GetAuthor(id int64) (bookscommon.AuthorRecord, error)
+	ListAuthors(query string) ([]bookscommon.AuthorRecord, error)
+	UpdateAuthor(record bookscommon.AuthorRecord) error
+	MergeAuthors(target int64, sources []int64) error
+	GetBooksByAuthor(authorID int64) ([]bookscommon.Info, error)
+
+	CountClassification(prefix string) (map[string]int, error)
+	GetBooksByClassification(prefix string) ([]bookscommon.Info, error)
+
+	GetCache(source, isbn string) (bookscommon.CacheEntry, error)
+	PutCache(entry bookscommon.CacheEntry) error
+	ListCache(isbn string) ([]bookscommon.CacheEntry, error)
+	DeleteCache(source, isbn string) error
 
 	Close() error
 }
@@ -0,0 +1,203 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+)
+
+const attachmentColumns = `id, book_id, position, kind, caption, width, height, created_time`
+
+// PutAttachment は写真を本の写真の最後に加える (attachment.Position は使わない)
+func (s *MySQL) PutAttachment(attachment bookscommon.Attachment) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			if err := tx.Rollback(); err != nil {
+				s.lg.Error("failed to rollback at transaction", slog.String("err", err.Error()))
+			}
+			s.lg.Error("failed to reconver", slog.Any("p", p))
+		} else if err != nil {
+			if err := tx.Rollback(); err != nil {
+				s.lg.Error("failed to rollback at transaction", slog.String("err", err.Error()))
+			}
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	var position int
+	if err := tx.QueryRow(`SELECT COALESCE(MAX(position) + 1, 0) FROM attachments WHERE book_id = ? FOR UPDATE`,
+		attachment.BookID).Scan(&position); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	_, err = tx.Exec(`INSERT INTO attachments(id, book_id, position, kind, caption, width, height) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		attachment.ID, attachment.BookID, position, attachment.Kind.String(), attachment.Caption, attachment.Width, attachment.Height)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQL) GetAttachments(bookID string) ([]bookscommon.Attachment, error) {
+	rows, err := s.db.Query(`SELECT `+attachmentColumns+` FROM attachments WHERE book_id = ? ORDER BY position`, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return s.rowConvertAttachments(rows)
+}
+
+func (s *MySQL) GetAttachment(bookID, id string) (bookscommon.Attachment, error) {
+	rows, err := s.db.Query(`SELECT `+attachmentColumns+` FROM attachments WHERE book_id = ? AND id = ?`, bookID, id)
+	if err != nil {
+		return bookscommon.Attachment{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+	attachments, err := s.rowConvertAttachments(rows)
+	if err != nil {
+		return bookscommon.Attachment{}, err
+	}
+	if len(attachments) == 0 {
+		return bookscommon.Attachment{}, storecommon.ErrNotFoundAttachment
+	}
+	return attachments[0], nil
+}
+
+// DeleteAttachment は写真を消し、後ろの写真を詰める
+func (s *MySQL) DeleteAttachment(bookID, id string) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			if err := tx.Rollback(); err != nil {
+				s.lg.Error("failed to rollback at transaction", slog.String("err", err.Error()))
+			}
+			s.lg.Error("failed to reconver", slog.Any("p", p))
+		} else if err != nil {
+			if err := tx.Rollback(); err != nil {
+				s.lg.Error("failed to rollback at transaction", slog.String("err", err.Error()))
+			}
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	var position int
+	err = tx.QueryRow(`SELECT position FROM attachments WHERE book_id = ? AND id = ? FOR UPDATE`, bookID, id).Scan(&position)
+	if errors.Is(err, sql.ErrNoRows) {
+		return storecommon.ErrNotFoundAttachment
+	} else if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	if _, err := tx.Exec(`DELETE FROM attachments WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	if _, err := tx.Exec(`UPDATE attachments SET position = position - 1 WHERE book_id = ? AND position > ?`, bookID, position); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// ReorderAttachments は本の写真を ids の順に並べ直す
+// ids が本の写真をちょうど一つずつ含まない場合は storecommon.ErrAttachmentOrder を返す
+func (s *MySQL) ReorderAttachments(bookID string, ids []string) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			if err := tx.Rollback(); err != nil {
+				s.lg.Error("failed to rollback at transaction", slog.String("err", err.Error()))
+			}
+			s.lg.Error("failed to reconver", slog.Any("p", p))
+		} else if err != nil {
+			if err := tx.Rollback(); err != nil {
+				s.lg.Error("failed to rollback at transaction", slog.String("err", err.Error()))
+			}
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	rows, err := tx.Query(`SELECT id FROM attachments WHERE book_id = ? FOR UPDATE`, bookID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	current := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan attachment row: %w", err)
+		}
+		current[id] = true
+	}
+	if err := rows.Close(); err != nil {
+		return fmt.Errorf("failed to close query result: %w", err)
+	}
+
+	if len(ids) != len(current) {
+		return storecommon.ErrAttachmentOrder
+	}
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if !current[id] || seen[id] {
+			return storecommon.ErrAttachmentOrder
+		}
+		seen[id] = true
+	}
+	for i, id := range ids {
+		if _, err := tx.Exec(`UPDATE attachments SET position = ? WHERE id = ?`, i, id); err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *MySQL) rowConvertAttachments(rows *sql.Rows) ([]bookscommon.Attachment, error) {
+	defer func() {
+		if err := rows.Close(); err != nil {
+			s.lg.Error("failed to close query result", slog.String("err", err.Error()))
+		}
+	}()
+
+	var attachments []bookscommon.Attachment
+	for rows.Next() {
+		var attachment bookscommon.Attachment
+		var kind string
+		if err := rows.Scan(
+			&attachment.ID,
+			&attachment.BookID,
+			&attachment.Position,
+			&kind,
+			&attachment.Caption,
+			&attachment.Width,
+			&attachment.Height,
+			&attachment.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan attachment row: %w", err)
+		}
+		var err error
+		attachment.Kind, err = bookscommon.AttachmentKindString(kind)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get attachment kind: %w", err)
+		}
+		attachments = append(attachments, attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("attachments rows iteration error: %w", err)
+	}
+	return attachments, nil
+}
@@ -0,0 +1,343 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+)
+
+// execer は *sql.DB と *sql.Tx のどちらでも使えるようにする
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+func (s *MySQL) initAuthorRecords() error {
+	_, err := s.db.Exec(`CREATE TABLE IF NOT EXISTS author_records(
+		id bigint AUTO_INCREMENT PRIMARY KEY,
+		name varchar(200) NOT NULL,
+		reading varchar(200) NOT NULL DEFAULT ''
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create table: %w", err)
+	}
+
+	// normalized は NormalizeName した表記で、同じ表記は一人にしか結び付かない
+	_, err = s.db.Exec(`CREATE TABLE IF NOT EXISTS author_aliases(
+		normalized varchar(200) PRIMARY KEY,
+		alias varchar(200) NOT NULL,
+		author_id bigint NOT NULL,
+		KEY author_id (author_id)
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create table: %w", err)
+	}
+
+	exists, err := s.columnExists("authors", "author_id")
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if _, err := s.db.Exec(`ALTER TABLE authors ADD COLUMN author_id bigint NULL, ADD KEY author_id (author_id)`); err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		s.lg.Info("added author_id column to authors table")
+	}
+
+	// 典拠レコードに結び付いていない名前を結び付ける
+	rows, err := s.db.Query(`SELECT DISTINCT author FROM authors WHERE author_id IS NULL`)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan author row: %w", err)
+		}
+		names = append(names, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("authors rows iteration error: %w", err)
+	}
+	for _, name := range names {
+		authorID, err := s.resolveAuthor(s.db, name, "")
+		if err != nil {
+			return fmt.Errorf("failed to resolve author: %w", err)
+		}
+		if _, err := s.db.Exec(`UPDATE authors SET author_id = ? WHERE author = ? AND author_id IS NULL`, authorID, name); err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+	}
+	if len(names) > 0 {
+		s.lg.Info("linked authors to author records", slog.Int("count", len(names)))
+	}
+	return nil
+}
+
+// resolveAuthor は名前の表記ゆれを吸収して典拠レコードの ID を返す
+// 見つからない場合はその名前を代表の表記にして新しく作る
+// reading は典拠レコードにまだ読みが無い場合だけ記録する
+func (s *MySQL) resolveAuthor(e execer, name, reading string) (int64, error) {
+	normalized := bookscommon.NormalizeName(name)
+	var authorID int64
+	err := e.QueryRow(`SELECT author_id FROM author_aliases WHERE normalized = ?`, normalized).Scan(&authorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		authorID, err = s.insertAuthor(e, normalized, name, reading)
+		if err != nil {
+			return 0, err
+		}
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if reading != "" {
+		if _, err := e.Exec(`UPDATE author_records SET reading = ? WHERE id = ? AND reading = ''`, reading, authorID); err != nil {
+			return 0, fmt.Errorf("failed to execute query: %w", err)
+		}
+	}
+	return authorID, nil
+}
+
+// insertAuthor は新しい典拠レコードを作り、normalized の別名を結び付ける
+// 同時に同じ名前を登録したリクエストに別名を先に取られた場合は、作ったレコードを消して先の方の ID を返す
+func (s *MySQL) insertAuthor(e execer, normalized, name, reading string) (int64, error) {
+	result, err := e.Exec(`INSERT INTO author_records(name, reading) VALUES (?, ?)`, name, reading)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	authorID, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get author id: %w", err)
+	}
+	// normalized は主キーなので、同じ表記を二人に結び付けることはない
+	result, err = e.Exec(`INSERT IGNORE INTO author_aliases(normalized, alias, author_id) VALUES (?, ?, ?)`, normalized, name, authorID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if inserted > 0 {
+		return authorID, nil
+	}
+
+	if _, err := e.Exec(`DELETE FROM author_records WHERE id = ?`, authorID); err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	// トランザクションの中でも先に登録された行が見えるようにロックして読む
+	var existing int64
+	if err := e.QueryRow(`SELECT author_id FROM author_aliases WHERE normalized = ? LOCK IN SHARE MODE`, normalized).Scan(&existing); err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	s.lg.Info("author was registered concurrently", slog.String("name", name), slog.Int64("author_id", existing))
+	return existing, nil
+}
+
+// ResolveAuthor は別名も含めて名前から典拠レコードの ID を探す
+func (s *MySQL) ResolveAuthor(name string) (int64, error) {
+	var authorID int64
+	err := s.db.QueryRow(`SELECT author_id FROM author_aliases WHERE normalized = ?`, bookscommon.NormalizeName(name)).Scan(&authorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, storecommon.ErrNotFoundAuthor
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return authorID, nil
+}
+
+func (s *MySQL) GetAuthor(id int64) (bookscommon.AuthorRecord, error) {
+	record := bookscommon.AuthorRecord{ID: id}
+	err := s.db.QueryRow(`SELECT name, reading FROM author_records WHERE id = ?`, id).Scan(&record.Name, &record.Reading)
+	if errors.Is(err, sql.ErrNoRows) {
+		return bookscommon.AuthorRecord{}, storecommon.ErrNotFoundAuthor
+	} else if err != nil {
+		return bookscommon.AuthorRecord{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+	record.Aliases, err = s.getAliases(id)
+	if err != nil {
+		return bookscommon.AuthorRecord{}, fmt.Errorf("failed to get aliases: %w", err)
+	}
+	return record, nil
+}
+
+// ListAuthors は代表の表記か別名に query を含む典拠レコードを返す (空の場合は全て)
+func (s *MySQL) ListAuthors(query string) ([]bookscommon.AuthorRecord, error) {
+	rows, err := s.db.Query(`SELECT id, name, reading FROM author_records
+		WHERE id IN (SELECT author_id FROM author_aliases WHERE normalized LIKE ?)
+		ORDER BY reading, name`, "%"+bookscommon.NormalizeName(query)+"%")
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	var records []bookscommon.AuthorRecord
+	for rows.Next() {
+		var record bookscommon.AuthorRecord
+		if err := rows.Scan(&record.ID, &record.Name, &record.Reading); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan author record row: %w", err)
+		}
+		records = append(records, record)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("author records rows iteration error: %w", err)
+	}
+
+	for i := range records {
+		records[i].Aliases, err = s.getAliases(records[i].ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get aliases: %w", err)
+		}
+	}
+	return records, nil
+}
+
+func (s *MySQL) getAliases(authorID int64) ([]string, error) {
+	rows, err := s.db.Query(`SELECT alias FROM author_aliases WHERE author_id = ? ORDER BY alias`, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query aliases: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			s.lg.Error("failed to close query result", slog.String("err", err.Error()))
+		}
+	}()
+
+	var aliases []string
+	for rows.Next() {
+		var alias string
+		if err := rows.Scan(&alias); err != nil {
+			return nil, fmt.Errorf("failed to scan alias row: %w", err)
+		}
+		aliases = append(aliases, alias)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("aliases rows iteration error: %w", err)
+	}
+	return aliases, nil
+}
+
+// UpdateAuthor は代表の表記・読み・別名を置き換える
+// 代表の表記は常に別名に含め、他の人物の別名と重なる場合は ErrAliasConflict を返す
+func (s *MySQL) UpdateAuthor(record bookscommon.AuthorRecord) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				s.lg.Error("failed to rollback at transaction", slog.String("err", err.Error()))
+			}
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	var exists bool
+	if err = tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM author_records WHERE id = ?)`, record.ID).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	if !exists {
+		return storecommon.ErrNotFoundAuthor
+	}
+	if _, err = tx.Exec(`UPDATE author_records SET name = ?, reading = ? WHERE id = ?`, record.Name, record.Reading, record.ID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	aliases := make(map[string]string)
+	for _, alias := range append([]string{record.Name}, record.Aliases...) {
+		if normalized := bookscommon.NormalizeName(alias); normalized != "" {
+			if _, ok := aliases[normalized]; !ok {
+				aliases[normalized] = alias
+			}
+		}
+	}
+
+	if _, err = tx.Exec(`DELETE FROM author_aliases WHERE author_id = ?`, record.ID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	for normalized, alias := range aliases {
+		var other int64
+		err = tx.QueryRow(`SELECT author_id FROM author_aliases WHERE normalized = ?`, normalized).Scan(&other)
+		if err == nil {
+			return fmt.Errorf("%w: %s is an alias of author %d", storecommon.ErrAliasConflict, alias, other)
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		if _, err = tx.Exec(`INSERT INTO author_aliases(normalized, alias, author_id) VALUES (?, ?, ?)`, normalized, alias, record.ID); err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+	}
+	return nil
+}
+
+// MergeAuthors は sources の別名と本を target にまとめ、sources を削除する
+func (s *MySQL) MergeAuthors(target int64, sources []int64) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				s.lg.Error("failed to rollback at transaction", slog.String("err", err.Error()))
+			}
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	for _, id := range append([]int64{target}, sources...) {
+		var exists bool
+		if err = tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM author_records WHERE id = ?)`, id).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("%w: %d", storecommon.ErrNotFoundAuthor, id)
+		}
+	}
+
+	for _, source := range sources {
+		if source == target {
+			continue
+		}
+		if _, err = tx.Exec(`UPDATE author_aliases SET author_id = ? WHERE author_id = ?`, target, source); err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		if _, err = tx.Exec(`UPDATE authors SET author_id = ? WHERE author_id = ?`, target, source); err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		// 読みが無い場合は統合元の読みを使う
+		if _, err = tx.Exec(`UPDATE author_records AS t JOIN author_records AS src ON src.id = ?
+			SET t.reading = src.reading WHERE t.id = ? AND t.reading = ''`, source, target); err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		if _, err = tx.Exec(`DELETE FROM author_records WHERE id = ?`, source); err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetBooksByAuthor は典拠レコードに結び付いた本を返す
+func (s *MySQL) GetBooksByAuthor(authorID int64) ([]bookscommon.Info, error) {
+	rows, err := s.db.Query(`SELECT `+bookColumns+`
+		FROM books WHERE deleted = false AND id IN (SELECT book_id FROM authors WHERE author_id = ?)
+		ORDER BY publishdate DESC`, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	books, err := s.rowConvertInfo(rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert info: %w", err)
+	}
+	return books, nil
+}
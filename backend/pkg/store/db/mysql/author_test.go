@@ -0,0 +1,82 @@
+package mysql
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type fakeResult struct {
+	id, rows int64
+}
+
+func (r fakeResult) LastInsertId() (int64, error) { return r.id, nil }
+
+func (r fakeResult) RowsAffected() (int64, error) { return r.rows, nil }
+
+// 同じ名前が同時に登録された場合は別名を先に取った方の典拠レコードを使う
+func TestResolveAuthorConcurrentInsert(t *testing.T) {
+	tests := []struct {
+		name string
+		// aliasInserted は INSERT IGNORE で別名を登録できたかどうか
+		aliasInserted bool
+		want          int64
+		wantDeleted   []int64
+	}{
+		{name: "inserted", aliasInserted: true, want: 7},
+		{name: "lost race", aliasInserted: false, want: 3, wantDeleted: []int64{7}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestMySQL(t)
+			if err := s.db.Ping(); err != nil {
+				t.Fatalf("Ping() error = %v", err)
+			}
+			var mu sync.Mutex
+			var deleted []int64
+			var readingFor int64
+			testSchema(t).respond = func(query string, args []driver.NamedValue) (*fakeRows, driver.Result, bool) {
+				mu.Lock()
+				defer mu.Unlock()
+				switch {
+				case strings.HasSuffix(query, "LOCK IN SHARE MODE"):
+					return &fakeRows{columns: []string{"author_id"}, values: [][]driver.Value{{int64(3)}}}, nil, true
+				case strings.HasPrefix(query, "SELECT author_id FROM author_aliases"):
+					return &fakeRows{columns: []string{"author_id"}}, nil, true
+				case strings.HasPrefix(query, "INSERT INTO author_records"):
+					return nil, fakeResult{id: 7, rows: 1}, true
+				case strings.HasPrefix(query, "INSERT IGNORE INTO author_aliases"):
+					if tt.aliasInserted {
+						return nil, fakeResult{rows: 1}, true
+					}
+					return nil, fakeResult{}, true
+				case strings.HasPrefix(query, "DELETE FROM author_records"):
+					deleted = append(deleted, args[0].Value.(int64))
+					return nil, fakeResult{rows: 1}, true
+				case strings.HasPrefix(query, "UPDATE author_records SET reading"):
+					readingFor = args[1].Value.(int64)
+					return nil, fakeResult{}, true
+				}
+				t.Errorf("unexpected query: %s", query)
+				return nil, fakeResult{}, true
+			}
+
+			got, err := s.resolveAuthor(s.db, "夏目 漱石", "ナツメ ソウセキ")
+			if err != nil {
+				t.Fatalf("resolveAuthor() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveAuthor() = %d, want %d", got, tt.want)
+			}
+			if !reflect.DeepEqual(deleted, tt.wantDeleted) {
+				t.Errorf("deleted author records = %v, want %v", deleted, tt.wantDeleted)
+			}
+			// 読みは使うことになった典拠レコードに記録する
+			if readingFor != tt.want {
+				t.Errorf("reading updated for %d, want %d", readingFor, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package mysql
+
+import (
+	"fmt"
+	"log/slog"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+)
+
+// CountClassification は prefix の一つ下の区分ごとに本の数を数える
+// prefix が空の場合は類、1 桁の場合は綱、2 桁の場合は目ごとになる
+func (s *MySQL) CountClassification(prefix string) (map[string]int, error) {
+	rows, err := s.db.Query(`SELECT SUBSTRING(ndc, 1, ?) AS code, COUNT(*) FROM books
+		WHERE deleted = false AND ndc LIKE ? AND CHAR_LENGTH(ndc) > ?
+		GROUP BY code`, len(prefix)+1, prefix+"%", len(prefix))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			s.lg.Error("failed to close query result", slog.String("err", err.Error()))
+		}
+	}()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var code string
+		var count int
+		if err := rows.Scan(&code, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan classification row: %w", err)
+		}
+		counts[code] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("classification rows iteration error: %w", err)
+	}
+	return counts, nil
+}
+
+// GetBooksByClassification は分類記号が prefix で始まる本を分類記号順に返す
+func (s *MySQL) GetBooksByClassification(prefix string) ([]bookscommon.Info, error) {
+	rows, err := s.db.Query(`SELECT `+bookColumns+`
+		FROM books WHERE deleted = false AND ndc LIKE ? ORDER BY ndc, title`, prefix+"%")
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	books, err := s.rowConvertInfo(rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert info: %w", err)
+	}
+	return books, nil
+}
@@ -0,0 +1,88 @@
+package mysql
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+)
+
+// putCoverCandidates は表紙の候補を入れ直す
+// 同じ URL の候補は測った大きさを引き継ぐ
+func (s *MySQL) putCoverCandidates(tx *sql.Tx, book bookscommon.Info) error {
+	type size struct{ width, height int }
+	sizes := make(map[string]size)
+	rows, err := tx.Query(`SELECT url, width, height FROM cover_candidates WHERE book_id = ?`, book.ID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	for rows.Next() {
+		var u string
+		var sz size
+		if err := rows.Scan(&u, &sz.width, &sz.height); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan cover candidate row: %w", err)
+		}
+		sizes[u] = sz
+	}
+	if err := rows.Close(); err != nil {
+		return fmt.Errorf("failed to close query result: %w", err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM cover_candidates WHERE book_id = ?`, book.ID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	for i, cover := range book.Covers {
+		u := cover.URL.String()
+		sz, ok := sizes[u]
+		if !ok || cover.Width > 0 {
+			sz = size{cover.Width, cover.Height}
+		}
+		_, err := tx.Exec(`INSERT INTO cover_candidates(book_id, position, source, label, url, width, height) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			book.ID, i, cover.Source, cover.Label, u, sz.width, sz.height)
+		if err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *MySQL) GetCoverCandidates(id string) ([]bookscommon.CoverCandidate, error) {
+	rows, err := s.db.Query(`SELECT source, label, url, width, height FROM cover_candidates WHERE book_id = ? ORDER BY position`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			s.lg.Error("failed to close query result", slog.String("err", err.Error()))
+		}
+	}()
+
+	var candidates []bookscommon.CoverCandidate
+	for rows.Next() {
+		var candidate bookscommon.CoverCandidate
+		var u string
+		if err := rows.Scan(&candidate.Source, &candidate.Label, &u, &candidate.Width, &candidate.Height); err != nil {
+			return nil, fmt.Errorf("failed to scan cover candidate row: %w", err)
+		}
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cover url: %w", err)
+		}
+		candidate.URL = *parsed
+		candidates = append(candidates, candidate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("cover candidates rows iteration error: %w", err)
+	}
+	return candidates, nil
+}
+
+func (s *MySQL) PutCoverCandidateSize(id, url string, width, height int) error {
+	if _, err := s.db.Exec(`UPDATE cover_candidates SET width = ?, height = ? WHERE book_id = ? AND url = ?`, width, height, id, url); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,319 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// fakeDriver はスキーマだけを持つ MySQL の代わりで、Init の移行を確かめるのに使う
+// テーブル・カラム・インデックスの有無を MySQL と同じように検査し、行は持たない
+type fakeDriver struct {
+	mu      sync.Mutex
+	schemas map[string]*fakeSchema
+}
+
+var testDriver = &fakeDriver{schemas: make(map[string]*fakeSchema)}
+
+func init() {
+	sql.Register("fakemysql", testDriver)
+}
+
+type fakeIndex struct {
+	unique  bool
+	columns []string
+}
+
+type fakeTable struct {
+	columns []string
+	indexes map[string]*fakeIndex
+}
+
+type fakeSchema struct {
+	mu     sync.Mutex
+	tables map[string]*fakeTable
+	// respond は行を返すクエリの結果を決める (ok が false の場合はスキーマだけで処理する)
+	respond func(query string, args []driver.NamedValue) (rows *fakeRows, result driver.Result, ok bool)
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	schema, ok := d.schemas[name]
+	if !ok {
+		schema = &fakeSchema{tables: make(map[string]*fakeTable)}
+		d.schemas[name] = schema
+	}
+	return &fakeConn{schema: schema}, nil
+}
+
+type fakeConn struct {
+	schema *fakeSchema
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepare is not supported")
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) { return c, nil }
+
+func (c *fakeConn) Commit() error { return nil }
+
+func (c *fakeConn) Rollback() error { return nil }
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if _, result, ok := c.schema.responded(query, args); ok {
+		return result, nil
+	}
+	if _, err := c.schema.run(query, args); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if rows, _, ok := c.schema.responded(query, args); ok {
+		return rows, nil
+	}
+	return c.schema.run(query, args)
+}
+
+func (s *fakeSchema) responded(query string, args []driver.NamedValue) (*fakeRows, driver.Result, bool) {
+	s.mu.Lock()
+	respond := s.respond
+	s.mu.Unlock()
+	if respond == nil {
+		return nil, nil, false
+	}
+	return respond(strings.Join(strings.Fields(query), " "), args)
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+var (
+	createPattern = regexp.MustCompile(`^CREATE TABLE IF NOT EXISTS (\w+)\s*\((.*)\)$`)
+	alterPattern  = regexp.MustCompile(`^ALTER TABLE (\w+) (.*)$`)
+	tablePattern  = regexp.MustCompile(`(?i)\b(?:INTO|UPDATE(?: IGNORE)?|FROM|JOIN) (\w+(?:\.\w+)?)`)
+	keyPattern    = regexp.MustCompile(`\(([^)]*)\)`)
+)
+
+func (s *fakeSchema) run(query string, args []driver.NamedValue) (*fakeRows, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	query = strings.Join(strings.Fields(query), " ")
+
+	if m := createPattern.FindStringSubmatch(query); m != nil {
+		if _, ok := s.tables[m[1]]; !ok {
+			s.tables[m[1]] = parseTable(m[2])
+		}
+		return &fakeRows{}, nil
+	}
+	if m := alterPattern.FindStringSubmatch(query); m != nil {
+		table, ok := s.tables[m[1]]
+		if !ok {
+			return nil, fmt.Errorf("Error 1146: Table '%s' doesn't exist", m[1])
+		}
+		for _, clause := range splitTopLevel(m[2]) {
+			if err := table.alter(clause); err != nil {
+				return nil, err
+			}
+		}
+		return &fakeRows{}, nil
+	}
+	if strings.Contains(query, "information_schema.COLUMNS") {
+		var count int64
+		if table, ok := s.tables[args[0].Value.(string)]; ok && slices.Contains(table.columns, args[1].Value.(string)) {
+			count = 1
+		}
+		return &fakeRows{columns: []string{"COUNT(*)"}, values: [][]driver.Value{{count}}}, nil
+	}
+
+	if strings.Contains(query, "information_schema.STATISTICS") {
+		rows := &fakeRows{columns: []string{"INDEX_NAME"}}
+		if table, ok := s.tables[args[0].Value.(string)]; ok {
+			var names []string
+			for name, index := range table.indexes {
+				if index.unique && name != "PRIMARY" && slices.Contains(index.columns, args[1].Value.(string)) {
+					names = append(names, name)
+				}
+			}
+			slices.Sort(names)
+			for _, name := range names {
+				rows.values = append(rows.values, []driver.Value{name})
+			}
+		}
+		return rows, nil
+	}
+
+	for _, m := range tablePattern.FindAllStringSubmatch(query, -1) {
+		if _, ok := s.tables[m[1]]; !ok && !strings.Contains(m[1], ".") {
+			return nil, fmt.Errorf("Error 1146: Table '%s' doesn't exist", m[1])
+		}
+	}
+	return &fakeRows{}, nil
+}
+
+// splitTopLevel は括弧の外のカンマで区切る
+func splitTopLevel(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, strings.TrimSpace(s[start:]))
+}
+
+func keyColumns(def string) []string {
+	m := keyPattern.FindStringSubmatch(def)
+	if m == nil {
+		return nil
+	}
+	var columns []string
+	for _, c := range strings.Split(m[1], ",") {
+		columns = append(columns, strings.TrimSpace(c))
+	}
+	return columns
+}
+
+func parseTable(body string) *fakeTable {
+	table := &fakeTable{indexes: make(map[string]*fakeIndex)}
+	for _, def := range splitTopLevel(body) {
+		if err := table.add(def); err != nil {
+			panic(err)
+		}
+	}
+	return table
+}
+
+// add は CREATE TABLE と ALTER TABLE ... ADD のカラムかインデックスを一つ加える
+func (t *fakeTable) add(def string) error {
+	def = strings.TrimPrefix(def, "COLUMN ")
+	fields := strings.Fields(def)
+	var name string
+	var index *fakeIndex
+	switch {
+	case strings.HasPrefix(def, "PRIMARY KEY"):
+		name, index = "PRIMARY", &fakeIndex{unique: true, columns: keyColumns(def)}
+	case strings.HasPrefix(def, "UNIQUE KEY"):
+		name, index = fields[2], &fakeIndex{unique: true, columns: keyColumns(def)}
+	case strings.HasPrefix(def, "KEY"):
+		name, index = fields[1], &fakeIndex{columns: keyColumns(def)}
+	default:
+		column := fields[0]
+		if slices.Contains(t.columns, column) {
+			return fmt.Errorf("Error 1060: Duplicate column name '%s'", column)
+		}
+		t.columns = append(t.columns, column)
+		switch {
+		case strings.Contains(def, "PRIMARY KEY"):
+			name, index = "PRIMARY", &fakeIndex{unique: true, columns: []string{column}}
+		case slices.Contains(fields, "UNIQUE"):
+			name, index = column, &fakeIndex{unique: true, columns: []string{column}}
+		default:
+			return nil
+		}
+	}
+	if _, ok := t.indexes[name]; ok {
+		if name == "PRIMARY" {
+			return fmt.Errorf("Error 1068: Multiple primary key defined")
+		}
+		return fmt.Errorf("Error 1061: Duplicate key name '%s'", name)
+	}
+	t.indexes[name] = index
+	return nil
+}
+
+func (t *fakeTable) alter(clause string) error {
+	fields := strings.Fields(strings.ReplaceAll(clause, "`", ""))
+	switch {
+	case strings.HasPrefix(clause, "ADD "):
+		return t.add(strings.TrimPrefix(clause, "ADD "))
+	case clause == "DROP PRIMARY KEY":
+		return t.dropIndex("PRIMARY")
+	case strings.HasPrefix(clause, "DROP INDEX "):
+		return t.dropIndex(fields[2])
+	case strings.HasPrefix(clause, "RENAME INDEX "):
+		index, ok := t.indexes[fields[2]]
+		if !ok {
+			return fmt.Errorf("Error 1176: Key '%s' doesn't exist in table", fields[2])
+		}
+		delete(t.indexes, fields[2])
+		t.indexes[fields[4]] = index
+		return nil
+	case strings.HasPrefix(clause, "DROP COLUMN "):
+		i := slices.Index(t.columns, fields[2])
+		if i < 0 {
+			return fmt.Errorf("Error 1091: Can't DROP '%s'; check that column/key exists", fields[2])
+		}
+		t.columns = slices.Delete(t.columns, i, i+1)
+		for name, index := range t.indexes {
+			if index.columns = slices.DeleteFunc(index.columns, func(c string) bool { return c == fields[2] }); len(index.columns) == 0 {
+				delete(t.indexes, name)
+			}
+		}
+		return nil
+	case strings.HasPrefix(clause, "CHANGE COLUMN "):
+		i := slices.Index(t.columns, fields[2])
+		if i < 0 {
+			return fmt.Errorf("Error 1054: Unknown column '%s'", fields[2])
+		}
+		// インデックスの名前は変わらない
+		t.columns[i] = fields[3]
+		for _, index := range t.indexes {
+			for j, c := range index.columns {
+				if c == fields[2] {
+					index.columns[j] = fields[3]
+				}
+			}
+		}
+		return nil
+	case strings.HasPrefix(clause, "MODIFY "):
+		if !slices.Contains(t.columns, fields[1]) {
+			return fmt.Errorf("Error 1054: Unknown column '%s'", fields[1])
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported clause: %s", clause)
+}
+
+func (t *fakeTable) dropIndex(name string) error {
+	if _, ok := t.indexes[name]; !ok {
+		return fmt.Errorf("Error 1091: Can't DROP '%s'; check that column/key exists", name)
+	}
+	delete(t.indexes, name)
+	return nil
+}
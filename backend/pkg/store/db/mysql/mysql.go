@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/url"
-	"time"
+	"strings"
 
 	_ "github.com/go-sql-driver/mysql"
 	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
@@ -14,6 +14,27 @@ import (
 	storeconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/config"
 )
 
+// bookColumns は rowConvertInfo が読む books のカラム
+const bookColumns = `
+        id,
+        isbn,
+        title,
+        title_reading,
+        description,
+        publishdate,
+        publishdate_precision,
+        image,
+        publisher,
+        pages,
+        edition,
+        price_amount,
+        price_currency,
+        ndc,
+        series,
+        image_color,
+        image_blurhash,
+        image_origin`
+
 type MySQL struct {
 	lg *slog.Logger
 	db *sql.DB
@@ -45,12 +66,24 @@ func (s *MySQL) Close() error {
 
 func (s *MySQL) Init() error {
 	_, err := s.db.Exec(`CREATE TABLE IF NOT EXISTS books(
-		isbn varchar(14) PRIMARY KEY, 
+		id varchar(36) PRIMARY KEY,
+		isbn varchar(14) UNIQUE,
 		title varchar(200), 
+		title_reading varchar(400) NOT NULL DEFAULT '',
 		description varchar(2000),
 		publishdate date,
-		language varchar(8),
+		publishdate_precision varchar(8) NOT NULL DEFAULT 'Unknown',
 		image varchar(200),
+		publisher varchar(200) NOT NULL DEFAULT '',
+		pages int NOT NULL DEFAULT 0,
+		edition varchar(100) NOT NULL DEFAULT '',
+		price_amount decimal(12,2) NOT NULL DEFAULT 0,
+		price_currency varchar(3) NOT NULL DEFAULT '',
+		ndc varchar(16) NOT NULL DEFAULT '',
+		series varchar(200) NOT NULL DEFAULT '',
+		image_color varchar(7) NOT NULL DEFAULT '',
+		image_blurhash varchar(64) NOT NULL DEFAULT '',
+		image_origin varchar(16) NOT NULL DEFAULT 'Provider',
 		deleted boolean DEFAULT false,
 		updated_time DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
 	)`)
@@ -60,18 +93,326 @@ func (s *MySQL) Init() error {
 
 	_, err = s.db.Exec(`CREATE TABLE IF NOT EXISTS authors(
 		id int AUTO_INCREMENT PRIMARY KEY,
-		isbn varchar(14),
+		book_id varchar(36),
 		author varchar(200),
+		role varchar(16) NOT NULL DEFAULT 'Author',
 		deleted boolean DEFAULT false,
-		UNIQUE KEY isbn_author (isbn, author)
+		UNIQUE KEY book_author (book_id, author, role)
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create table: %w", err)
+	}
+
+	_, err = s.db.Exec(`CREATE TABLE IF NOT EXISTS identifiers(
+		book_id varchar(36) NOT NULL,
+		type varchar(8) NOT NULL,
+		value varchar(64) NOT NULL,
+		PRIMARY KEY (type, value),
+		KEY book_id (book_id)
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create table: %w", err)
+	}
+
+	_, err = s.db.Exec(`CREATE TABLE IF NOT EXISTS book_languages(
+		book_id varchar(36) NOT NULL,
+		language varchar(8) NOT NULL,
+		position int NOT NULL DEFAULT 0,
+		PRIMARY KEY (book_id, language)
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create table: %w", err)
+	}
+
+	_, err = s.db.Exec(`CREATE TABLE IF NOT EXISTS cover_candidates(
+		book_id varchar(36) NOT NULL,
+		position int NOT NULL,
+		source varchar(64) NOT NULL DEFAULT '',
+		label varchar(32) NOT NULL DEFAULT '',
+		url varchar(2048) NOT NULL,
+		width int NOT NULL DEFAULT 0,
+		height int NOT NULL DEFAULT 0,
+		PRIMARY KEY (book_id, position)
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create table: %w", err)
+	}
+
+	_, err = s.db.Exec(`CREATE TABLE IF NOT EXISTS subjects(
+		book_id varchar(36) NOT NULL,
+		subject varchar(200) NOT NULL,
+		PRIMARY KEY (book_id, subject)
 	)`)
 	if err != nil {
 		return fmt.Errorf("failed to create table: %w", err)
 	}
+
+	_, err = s.db.Exec(`CREATE TABLE IF NOT EXISTS attachments(
+		id varchar(36) NOT NULL,
+		book_id varchar(36) NOT NULL,
+		position int NOT NULL DEFAULT 0,
+		kind varchar(16) NOT NULL DEFAULT 'Other',
+		caption varchar(1024) NOT NULL DEFAULT '',
+		width int NOT NULL DEFAULT 0,
+		height int NOT NULL DEFAULT 0,
+		created_time DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (id),
+		KEY book_id (book_id, position)
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create table: %w", err)
+	}
+
+	_, err = s.db.Exec(`CREATE TABLE IF NOT EXISTS provider_cache(
+		source varchar(64),
+		isbn varchar(14),
+		response mediumtext,
+		not_found boolean DEFAULT false,
+		schema_version int NOT NULL DEFAULT 0,
+		created_time DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		expires_time DATETIME NOT NULL,
+		PRIMARY KEY (source, isbn)
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create table: %w", err)
+	}
+
+	// 移行はテーブルが全て揃ってから行う
+	if err := s.migrateBookID(); err != nil {
+		return fmt.Errorf("failed to migrate book id: %w", err)
+	}
+	if err := s.migrateBookColumns(); err != nil {
+		return fmt.Errorf("failed to migrate book columns: %w", err)
+	}
+	if err := s.migrateLanguage(); err != nil {
+		return fmt.Errorf("failed to migrate language: %w", err)
+	}
+	if err := s.migrateContributorRole(); err != nil {
+		return fmt.Errorf("failed to migrate contributor role: %w", err)
+	}
+	if err := s.migrateCacheSchema(); err != nil {
+		return fmt.Errorf("failed to migrate provider cache: %w", err)
+	}
+	if err := s.initAuthorRecords(); err != nil {
+		return fmt.Errorf("failed to initialize author records: %w", err)
+	}
+	return nil
+}
+
+// migrateBookID は ISBN を主キーにしていた古いテーブルを内部 ID を主キーにしたものに移行する
+// 既存の本の ID は ISBN と同じにする
+func (s *MySQL) migrateBookID() error {
+	exists, err := s.columnExists("books", "id")
+	if err != nil {
+		return err
+	}
+	if !exists {
+		for _, query := range []string{
+			`ALTER TABLE books ADD COLUMN id varchar(36) FIRST`,
+			`UPDATE books SET id = isbn`,
+			`ALTER TABLE books DROP PRIMARY KEY, MODIFY isbn varchar(14) NULL, ADD PRIMARY KEY (id), ADD UNIQUE KEY isbn (isbn)`,
+		} {
+			if _, err := s.db.Exec(query); err != nil {
+				return fmt.Errorf("failed to execute query: %w", err)
+			}
+		}
+		s.lg.Info("migrated books table to internal id")
+	}
+
+	exists, err = s.columnExists("authors", "isbn")
+	if err != nil {
+		return err
+	}
+	if exists {
+		if _, err := s.db.Exec(`ALTER TABLE authors CHANGE COLUMN isbn book_id varchar(36)`); err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		s.lg.Info("migrated authors table to internal id")
+	}
+
+	if _, err := s.db.Exec(`INSERT IGNORE INTO identifiers(book_id, type, value)
+		SELECT id, ?, isbn FROM books WHERE isbn IS NOT NULL AND isbn <> ''`, bookscommon.ISBN.String()); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
 	return nil
 }
 
-func (s *MySQL) Put(book bookscommon.Info) error {
+// migrateBookColumns は古い books テーブルに後から増えたカラムを追加する
+func (s *MySQL) migrateBookColumns() error {
+	for _, column := range []struct {
+		name       string
+		definition string
+	}{
+		{"publisher", `varchar(200) NOT NULL DEFAULT ''`},
+		{"pages", `int NOT NULL DEFAULT 0`},
+		{"edition", `varchar(100) NOT NULL DEFAULT ''`},
+		{"price_amount", `decimal(12,2) NOT NULL DEFAULT 0`},
+		{"price_currency", `varchar(3) NOT NULL DEFAULT ''`},
+		{"ndc", `varchar(16) NOT NULL DEFAULT ''`},
+		{"series", `varchar(200) NOT NULL DEFAULT ''`},
+		// 以前は年月までの精度で保存していた
+		{"publishdate_precision", `varchar(8) NOT NULL DEFAULT 'Month'`},
+		{"image_color", `varchar(7) NOT NULL DEFAULT ''`},
+		{"image_blurhash", `varchar(64) NOT NULL DEFAULT ''`},
+		{"image_origin", `varchar(16) NOT NULL DEFAULT 'Provider'`},
+		{"title_reading", `varchar(400) NOT NULL DEFAULT ''`},
+	} {
+		exists, err := s.columnExists("books", column.name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		if _, err := s.db.Exec(fmt.Sprintf("ALTER TABLE books ADD COLUMN %s %s", column.name, column.definition)); err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		s.lg.Info("added column to books table", slog.String("column", column.name))
+	}
+	return nil
+}
+
+// migrateCacheSchema は provider_cache に schema_version カラムを加える
+// 既存のエントリは 0 になり、読むときに古い形式として取り直す
+func (s *MySQL) migrateCacheSchema() error {
+	exists, err := s.columnExists("provider_cache", "schema_version")
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	if _, err := s.db.Exec(`ALTER TABLE provider_cache ADD COLUMN schema_version int NOT NULL DEFAULT 0`); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	s.lg.Info("added column to provider_cache table", slog.String("column", "schema_version"))
+	return nil
+}
+
+// migrateLanguage は books テーブルの language カラム (JP, EN, UNKOWN) を
+// ISO 639 のコードにして book_languages テーブルに移す
+func (s *MySQL) migrateLanguage() error {
+	exists, err := s.columnExists("books", "language")
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	if _, err := s.db.Exec(`INSERT IGNORE INTO book_languages(book_id, language, position)
+		SELECT id, CASE language WHEN 'JP' THEN 'ja' ELSE 'en' END, 0
+		FROM books WHERE language IN ('JP', 'EN')`); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	if _, err := s.db.Exec(`ALTER TABLE books DROP COLUMN language`); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	s.lg.Info("migrated language column to book_languages table")
+	return nil
+}
+
+// migrateContributorRole は authors テーブルに役割を追加し、
+// "山田太郎 著" のようにそのまま保存されていた名前を役割付きに分解する
+func (s *MySQL) migrateContributorRole() error {
+	exists, err := s.columnExists("authors", "role")
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	// 最初のテーブルでは isbn_author という名前だったので、今の名前を調べてから消す
+	indexes, err := s.uniqueIndexes("authors", "book_id")
+	if err != nil {
+		return err
+	}
+	query := `ALTER TABLE authors ADD COLUMN role varchar(16) NOT NULL DEFAULT 'Author'`
+	for _, index := range indexes {
+		query += ", DROP INDEX `" + index + "`"
+	}
+	query += `, ADD UNIQUE KEY book_author (book_id, author, role)`
+	if _, err := s.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	rows, err := s.db.Query(`SELECT id, book_id, author, deleted FROM authors`)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	type author struct {
+		id      int
+		bookID  string
+		name    string
+		deleted bool
+	}
+	var authors []author
+	for rows.Next() {
+		var a author
+		if err := rows.Scan(&a.id, &a.bookID, &a.name, &a.deleted); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan author row: %w", err)
+		}
+		authors = append(authors, a)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("authors rows iteration error: %w", err)
+	}
+
+	for _, a := range authors {
+		contributors := bookscommon.ParseContributors(a.name)
+		if len(contributors) == 0 {
+			continue
+		}
+		if _, err := s.db.Exec(`UPDATE IGNORE authors SET author = ?, role = ? WHERE id = ?`,
+			contributors[0].Name, contributors[0].Role.String(), a.id); err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		for _, c := range contributors[1:] {
+			if _, err := s.db.Exec(`INSERT IGNORE INTO authors(book_id, author, role, deleted) VALUES (?, ?, ?, ?)`,
+				a.bookID, c.Name, c.Role.String(), a.deleted); err != nil {
+				return fmt.Errorf("failed to execute query: %w", err)
+			}
+		}
+	}
+	s.lg.Info("migrated authors table to contributors with role")
+	return nil
+}
+
+// uniqueIndexes は column を含む主キー以外の一意なインデックスの名前を返す
+func (s *MySQL) uniqueIndexes(table, column string) ([]string, error) {
+	rows, err := s.db.Query(`SELECT DISTINCT INDEX_NAME FROM information_schema.STATISTICS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ? AND NON_UNIQUE = 0 AND INDEX_NAME <> 'PRIMARY'`, table, column)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check index: %w", err)
+	}
+	defer rows.Close()
+	var indexes []string
+	for rows.Next() {
+		var index string
+		if err := rows.Scan(&index); err != nil {
+			return nil, fmt.Errorf("failed to scan index row: %w", err)
+		}
+		indexes = append(indexes, index)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("index rows iteration error: %w", err)
+	}
+	return indexes, nil
+}
+
+func (s *MySQL) columnExists(table, column string) (bool, error) {
+	var count int
+	if err := s.db.QueryRow(`SELECT COUNT(*) FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?`, table, column).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to check column: %w", err)
+	}
+	return count > 0, nil
+}
+
+// Put は本の情報を一つのトランザクションで書き込む
+// 戻り値を名前付きにしているので、途中で失敗した場合は defer でロールバックされ、Commit の失敗も返る
+func (s *MySQL) Put(book bookscommon.Info) (err error) {
 	tx, err := s.db.Begin()
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -96,65 +437,183 @@ func (s *MySQL) Put(book bookscommon.Info) error {
 	if book.Publishdate.IsZero() {
 		pubDate = nil
 	} else {
-		pubDate = book.Publishdate
+		pubDate = book.Publishdate.Time
+	}
+
+	var isbn interface{}
+	if book.ISBN != "" {
+		isbn = book.ISBN
 	}
 
 	_, err = tx.Exec(`INSERT INTO books(
+		id,
 		isbn,
 		title,
+		title_reading,
 		description,
 		publishdate,
-		language,
-		image
-	) VALUES (?, ?, ?, ?, ?, ?)
+		publishdate_precision,
+		image,
+		publisher,
+		pages,
+		edition,
+		price_amount,
+		price_currency,
+		ndc,
+		series
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	 ON DUPLICATE KEY UPDATE
+	  isbn = VALUES(isbn),
 	  title = VALUES(title),
+	  title_reading = VALUES(title_reading),
     description = VALUES(description),
     publishdate = VALUES(publishdate),
-    language = VALUES(language),
-    image = VALUES(image),
+    publishdate_precision = VALUES(publishdate_precision),
+    image = IF(deleted OR image_origin NOT IN ('User', 'Selected'), VALUES(image), image),
+    image_origin = IF(deleted, 'Provider', image_origin),
+    publisher = VALUES(publisher),
+    pages = VALUES(pages),
+    edition = VALUES(edition),
+    price_amount = VALUES(price_amount),
+    price_currency = VALUES(price_currency),
+    ndc = VALUES(ndc),
+    series = VALUES(series),
 		deleted = false
 	 `,
-		book.ISBN,
+		book.ID,
+		isbn,
 		book.Title,
+		book.TitleReading,
 		book.Description,
 		pubDate,
-		book.Language.String(),
+		book.Publishdate.Precision.String(),
 		book.Image.Source.String(),
+		book.Publisher,
+		book.Pages,
+		book.Edition,
+		book.Price.Amount,
+		book.Price.Currency,
+		book.NDC,
+		book.Series,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
 
-	for _, author := range book.Authors {
-		_, err = tx.Exec(`INSERT INTO authors(
-			isbn,
-			author
-		) VALUES (?, ?)
-		ON DUPLICATE KEY UPDATE
-		  isbn = VALUES(isbn),
- 	    author = VALUES(author),
-			deleted = false
-		 `,
-			book.ISBN,
+	// 外れた著者が残らないように入れ直す
+	_, err = tx.Exec(`DELETE FROM authors WHERE book_id = ?`, book.ID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	for _, c := range book.Contributors {
+		authorID, err := s.resolveAuthor(tx, c.Name, c.Reading)
+		if err != nil {
+			return fmt.Errorf("failed to resolve author: %w", err)
+		}
+		_, err = tx.Exec(`INSERT IGNORE INTO authors(
+			book_id,
 			author,
+			role,
+			author_id
+		) VALUES (?, ?, ?, ?)
+		 `,
+			book.ID,
+			c.Name,
+			c.Role.String(),
+			authorID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to execute query: %w", err)
 		}
 	}
+
+	// 言語は並び順も含めて毎回入れ直す
+	_, err = tx.Exec(`DELETE FROM book_languages WHERE book_id = ?`, book.ID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	for i, language := range book.Languages {
+		_, err = tx.Exec(`INSERT IGNORE INTO book_languages(book_id, language, position) VALUES (?, ?, ?)`, book.ID, string(language), i)
+		if err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+	}
+
+	if err := s.putCoverCandidates(tx, book); err != nil {
+		return err
+	}
+
+	// 件名は毎回入れ直す
+	_, err = tx.Exec(`DELETE FROM subjects WHERE book_id = ?`, book.ID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	for _, subject := range book.Subjects {
+		_, err = tx.Exec(`INSERT IGNORE INTO subjects(book_id, subject) VALUES (?, ?)`, book.ID, subject)
+		if err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+	}
+
+	return s.putIdentifiers(tx, book)
+}
+
+// putIdentifiers は識別子を入れ直す
+// 削除されていない別の本が持つ識別子は奪わずに警告して飛ばす
+func (s *MySQL) putIdentifiers(tx *sql.Tx, book bookscommon.Info) error {
+	if _, err := tx.Exec(`DELETE FROM identifiers WHERE book_id = ?`, book.ID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	for _, id := range book.Identifiers {
+		var owner string
+		var deleted sql.NullBool
+		err := tx.QueryRow(`SELECT identifiers.book_id, books.deleted FROM identifiers
+			LEFT JOIN books ON books.id = identifiers.book_id
+			WHERE identifiers.type = ? AND identifiers.value = ?
+			FOR UPDATE`,
+			id.Type.String(), id.Value).Scan(&owner, &deleted)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			_, err = tx.Exec(`INSERT INTO identifiers(book_id, type, value) VALUES (?, ?, ?)`, book.ID, id.Type.String(), id.Value)
+		case err != nil:
+			return fmt.Errorf("failed to execute query: %w", err)
+		case deleted.Valid && !deleted.Bool:
+			s.lg.Warn("identifier belongs to another book",
+				slog.String("id", book.ID),
+				slog.String("owner", owner),
+				slog.String("type", id.Type.String()),
+				slog.String("value", id.Value),
+			)
+			continue
+		default:
+			// 削除済みの本や本の無い行からは引き取る
+			_, err = tx.Exec(`UPDATE identifiers SET book_id = ? WHERE type = ? AND value = ?`, book.ID, id.Type.String(), id.Value)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+	}
 	return nil
 }
 
-func (s *MySQL) Get(isbn string) (bookscommon.Info, error) {
-	row, err := s.db.Query(`SELECT 
-        isbn,
-        title,
-        description,
-        publishdate,
-        language,
-        image
-        FROM books WHERE isbn = ? AND deleted = false ORDER BY updated_time DESC`, isbn)
+// Resolve は識別子から本の ID を探す
+func (s *MySQL) Resolve(id bookscommon.Identifier) (string, error) {
+	var bookID string
+	err := s.db.QueryRow(`SELECT identifiers.book_id FROM identifiers
+		JOIN books ON books.id = identifiers.book_id
+		WHERE identifiers.type = ? AND identifiers.value = ? AND books.deleted = false`,
+		id.Type.String(), id.Value).Scan(&bookID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", storecommon.ErrNotFoundBook
+	} else if err != nil {
+		return "", fmt.Errorf("failed to execute query: %w", err)
+	}
+	return bookID, nil
+}
+
+func (s *MySQL) Get(id string) (bookscommon.Info, error) {
+	row, err := s.db.Query(`SELECT `+bookColumns+`
+        FROM books WHERE id = ? AND deleted = false ORDER BY updated_time DESC`, id)
 	if err != nil {
 		return bookscommon.Info{}, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -169,13 +628,7 @@ func (s *MySQL) Get(isbn string) (bookscommon.Info, error) {
 }
 
 func (s *MySQL) GetAll() ([]bookscommon.Info, error) {
-	rows, err := s.db.Query(`SELECT 
-        isbn,
-        title,
-        description,
-        publishdate,
-        language,
-        image FROM books WHERE deleted = false ORDER BY updated_time DESC`)
+	rows, err := s.db.Query(`SELECT ` + bookColumns + ` FROM books WHERE deleted = false ORDER BY updated_time DESC`)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -188,15 +641,25 @@ func (s *MySQL) GetAll() ([]bookscommon.Info, error) {
 	return books, nil
 }
 
-func (s *MySQL) Search(title string) ([]bookscommon.Info, error) {
-	rows, err := s.db.Query(`SELECT 
-        isbn,
-        title,
-        description,
-        publishdate,
-        language,
-        image
-				FROM books WHERE title LIKE ? AND deleted = false ORDER BY updated_time DESC`, "%"+title+"%")
+// Search は filter の条件を全て満たす本を返す
+func (s *MySQL) Search(filter bookscommon.Filter) ([]bookscommon.Info, error) {
+	conditions := []string{"deleted = false"}
+	var args []any
+	if filter.Title != "" {
+		conditions = append(conditions, "title LIKE ?")
+		args = append(args, "%"+filter.Title+"%")
+	}
+	if filter.Publisher != "" {
+		conditions = append(conditions, "publisher LIKE ?")
+		args = append(args, "%"+filter.Publisher+"%")
+	}
+	if filter.Subject != "" {
+		conditions = append(conditions, "id IN (SELECT book_id FROM subjects WHERE subject LIKE ?)")
+		args = append(args, "%"+filter.Subject+"%")
+	}
+
+	rows, err := s.db.Query(`SELECT `+bookColumns+`
+				FROM books WHERE `+strings.Join(conditions, " AND ")+` ORDER BY updated_time DESC`, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -209,26 +672,49 @@ func (s *MySQL) Search(title string) ([]bookscommon.Info, error) {
 	return books, nil
 }
 
-func (s *MySQL) Rename(isbn, title string) error {
-	if err := s.db.QueryRow(`UPDATE books SET title = ? WHERE isbn = ?`, title, isbn); err.Err() != nil {
+func (s *MySQL) Rename(id, title string) error {
+	if err := s.db.QueryRow(`UPDATE books SET title = ? WHERE id = ?`, title, id); err.Err() != nil {
 		return fmt.Errorf("failed to rename book: %w", err.Err())
 	}
 	return nil
 }
 
+func (s *MySQL) PutImageMeta(id string, image bookscommon.Image) error {
+	// Source が空の場合は取得元の URL を変えない
+	source := image.Source.String()
+	if _, err := s.db.Exec(`UPDATE books SET image_color = ?, image_blurhash = ?, image_origin = ?, image = IF(? = '', image, ?) WHERE id = ?`,
+		image.Color, image.Blurhash, image.Origin.String(), source, source, id); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
 func (s *MySQL) rowConvertInfo(rows *sql.Rows) ([]bookscommon.Info, error) {
 	var books []bookscommon.Info
 	for rows.Next() {
 		var book bookscommon.Info
-		var langStr, imgStr string
+		var isbn sql.NullString
+		var precisionStr, imgStr, originStr string
 		var pubDate sql.NullTime
 		err := rows.Scan(
-			&book.ISBN,
+			&book.ID,
+			&isbn,
 			&book.Title,
+			&book.TitleReading,
 			&book.Description,
 			&pubDate,
-			&langStr,
+			&precisionStr,
 			&imgStr,
+			&book.Publisher,
+			&book.Pages,
+			&book.Edition,
+			&book.Price.Amount,
+			&book.Price.Currency,
+			&book.NDC,
+			&book.Series,
+			&book.Image.Color,
+			&book.Image.Blurhash,
+			&originStr,
 		)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
@@ -237,52 +723,163 @@ func (s *MySQL) rowConvertInfo(rows *sql.Rows) ([]bookscommon.Info, error) {
 			return nil, fmt.Errorf("failed to scan book row: %w", err)
 		}
 
+		book.ISBN = isbn.String
+
 		if pubDate.Valid {
-			book.Publishdate = pubDate.Time
-		} else {
-			book.Publishdate = time.Time{}
+			precision, err := bookscommon.DatePrecisionString(precisionStr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get publishdate precision: %w", err)
+			}
+			book.Publishdate = bookscommon.NewDate(pubDate.Time, precision)
 		}
 
-		book.Language, err = bookscommon.LanguageString(langStr)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get language: %w", err)
-		}
 		imgurl, err := url.Parse(imgStr)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get image url: %w", err)
 		}
 		book.Image.Source = *imgurl
+		book.Image.Origin, err = bookscommon.ImageOriginString(originStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get image origin: %w", err)
+		}
 
-		rows, err := s.db.Query(`SELECT author FROM authors WHERE isbn = ?`, book.ISBN)
+		book.Contributors, err = s.getContributors(book.ID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to query authors: %w", err)
+			return nil, fmt.Errorf("failed to get contributors: %w", err)
 		}
-		defer func() {
-			if err != rows.Close() {
-				s.lg.Error("failed to close query result", slog.String("err", err.Error()))
-			}
-		}()
 
-		var authors []string
-		for rows.Next() {
-			var author string
-			if err := rows.Scan(&author); err != nil {
-				return nil, fmt.Errorf("failed to scan author row: %w", err)
-			}
-			authors = append(authors, author)
+		book.Identifiers, err = s.getIdentifiers(book.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get identifiers: %w", err)
 		}
-		if err := rows.Err(); err != nil {
-			return nil, fmt.Errorf("authors rows iteration error: %w", err)
+
+		book.Subjects, err = s.getSubjects(book.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get subjects: %w", err)
 		}
 
-		book.Authors = authors
+		book.Languages, err = s.getLanguages(book.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get languages: %w", err)
+		}
 
 		books = append(books, book)
 	}
 	return books, nil
 }
 
-func (s *MySQL) Delete(isbn string) error {
+func (s *MySQL) getContributors(bookID string) ([]bookscommon.Contributor, error) {
+	rows, err := s.db.Query(`SELECT authors.author, authors.role, authors.author_id, COALESCE(author_records.reading, '')
+		FROM authors LEFT JOIN author_records ON author_records.id = authors.author_id
+		WHERE authors.book_id = ? ORDER BY authors.id`, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query authors: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			s.lg.Error("failed to close query result", slog.String("err", err.Error()))
+		}
+	}()
+
+	var contributors []bookscommon.Contributor
+	for rows.Next() {
+		var name, roleStr, reading string
+		var authorID sql.NullInt64
+		if err := rows.Scan(&name, &roleStr, &authorID, &reading); err != nil {
+			return nil, fmt.Errorf("failed to scan author row: %w", err)
+		}
+		role, err := bookscommon.ContributorRoleString(roleStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get contributor role: %w", err)
+		}
+		contributors = append(contributors, bookscommon.Contributor{Name: name, Reading: reading, Role: role, AuthorID: authorID.Int64})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("authors rows iteration error: %w", err)
+	}
+	return contributors, nil
+}
+
+func (s *MySQL) getIdentifiers(bookID string) ([]bookscommon.Identifier, error) {
+	rows, err := s.db.Query(`SELECT type, value FROM identifiers WHERE book_id = ? ORDER BY type, value`, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query identifiers: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			s.lg.Error("failed to close query result", slog.String("err", err.Error()))
+		}
+	}()
+
+	var identifiers []bookscommon.Identifier
+	for rows.Next() {
+		var typeStr, value string
+		if err := rows.Scan(&typeStr, &value); err != nil {
+			return nil, fmt.Errorf("failed to scan identifier row: %w", err)
+		}
+		idType, err := bookscommon.IdentifierTypeString(typeStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get identifier type: %w", err)
+		}
+		identifiers = append(identifiers, bookscommon.Identifier{Type: idType, Value: value})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("identifiers rows iteration error: %w", err)
+	}
+	return identifiers, nil
+}
+
+func (s *MySQL) getSubjects(bookID string) ([]string, error) {
+	rows, err := s.db.Query(`SELECT subject FROM subjects WHERE book_id = ?`, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query subjects: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			s.lg.Error("failed to close query result", slog.String("err", err.Error()))
+		}
+	}()
+
+	var subjects []string
+	for rows.Next() {
+		var subject string
+		if err := rows.Scan(&subject); err != nil {
+			return nil, fmt.Errorf("failed to scan subject row: %w", err)
+		}
+		subjects = append(subjects, subject)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("subjects rows iteration error: %w", err)
+	}
+	return subjects, nil
+}
+
+func (s *MySQL) getLanguages(bookID string) ([]bookscommon.Language, error) {
+	rows, err := s.db.Query(`SELECT language FROM book_languages WHERE book_id = ? ORDER BY position`, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query languages: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			s.lg.Error("failed to close query result", slog.String("err", err.Error()))
+		}
+	}()
+
+	var languages []bookscommon.Language
+	for rows.Next() {
+		var language string
+		if err := rows.Scan(&language); err != nil {
+			return nil, fmt.Errorf("failed to scan language row: %w", err)
+		}
+		languages = append(languages, bookscommon.Language(language))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("languages rows iteration error: %w", err)
+	}
+	return languages, nil
+}
+
+func (s *MySQL) Delete(id string) (err error) {
 	tx, err := s.db.Begin()
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -303,11 +900,131 @@ func (s *MySQL) Delete(isbn string) error {
 		}
 	}()
 
-	if err := s.db.QueryRow(`UPDATE books SET deleted = true WHERE isbn = ?`, isbn); err.Err() != nil {
-		return fmt.Errorf("failed to execute query: %w", err.Err())
+	if _, err := tx.Exec(`UPDATE books SET deleted = true WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
 	}
-	if err := s.db.QueryRow(`UPDATE authors SET deleted = true WHERE isbn = ?`, isbn); err.Err() != nil {
-		return fmt.Errorf("failed to execute query: %w", err.Err())
+	if _, err := tx.Exec(`UPDATE authors SET deleted = true WHERE book_id = ?`, id); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	if _, err := tx.Exec(`DELETE FROM attachments WHERE book_id = ?`, id); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
 	}
 	return nil
 }
+
+func (s *MySQL) GetCache(source, isbn string) (bookscommon.CacheEntry, error) {
+	rows, err := s.db.Query(`SELECT
+		source,
+		isbn,
+		response,
+		not_found,
+		schema_version,
+		created_time,
+		expires_time
+		FROM provider_cache WHERE source = ? AND isbn = ?`, source, isbn)
+	if err != nil {
+		return bookscommon.CacheEntry{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+	entries, err := s.rowConvertCacheEntry(rows)
+	if err != nil {
+		return bookscommon.CacheEntry{}, fmt.Errorf("failed to convert cache entry: %w", err)
+	}
+	if len(entries) > 0 {
+		return entries[0], nil
+	}
+	return bookscommon.CacheEntry{}, storecommon.ErrNotFoundCache
+}
+
+func (s *MySQL) PutCache(entry bookscommon.CacheEntry) error {
+	_, err := s.db.Exec(`INSERT INTO provider_cache(
+		source,
+		isbn,
+		response,
+		not_found,
+		schema_version,
+		created_time,
+		expires_time
+	) VALUES (?, ?, ?, ?, ?, ?, ?)
+	 ON DUPLICATE KEY UPDATE
+	  response = VALUES(response),
+	  not_found = VALUES(not_found),
+	  schema_version = VALUES(schema_version),
+	  created_time = VALUES(created_time),
+	  expires_time = VALUES(expires_time)
+	 `,
+		entry.Source,
+		entry.ISBN,
+		string(entry.Response),
+		entry.NotFound,
+		entry.Schema,
+		entry.CreatedAt,
+		entry.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// ListCache は isbn のキャッシュを返す (isbn が空の場合は全て)
+func (s *MySQL) ListCache(isbn string) ([]bookscommon.CacheEntry, error) {
+	rows, err := s.db.Query(`SELECT
+		source,
+		isbn,
+		response,
+		not_found,
+		schema_version,
+		created_time,
+		expires_time
+		FROM provider_cache WHERE ? = '' OR isbn = ? ORDER BY created_time DESC`, isbn, isbn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	entries, err := s.rowConvertCacheEntry(rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert cache entry: %w", err)
+	}
+	return entries, nil
+}
+
+// DeleteCache は source と isbn のキャッシュを消す (空の場合はその条件で絞らない)
+func (s *MySQL) DeleteCache(source, isbn string) error {
+	if _, err := s.db.Exec(`DELETE FROM provider_cache WHERE (? = '' OR source = ?) AND (? = '' OR isbn = ?)`,
+		source, source, isbn, isbn); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+func (s *MySQL) rowConvertCacheEntry(rows *sql.Rows) ([]bookscommon.CacheEntry, error) {
+	defer func() {
+		if err := rows.Close(); err != nil {
+			s.lg.Error("failed to close query result", slog.String("err", err.Error()))
+		}
+	}()
+
+	var entries []bookscommon.CacheEntry
+	for rows.Next() {
+		var entry bookscommon.CacheEntry
+		var response sql.NullString
+		if err := rows.Scan(
+			&entry.Source,
+			&entry.ISBN,
+			&response,
+			&entry.NotFound,
+			&entry.Schema,
+			&entry.CreatedAt,
+			&entry.ExpiresAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan cache row: %w", err)
+		}
+		if response.Valid && response.String != "" {
+			entry.Response = []byte(response.String)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("cache rows iteration error: %w", err)
+	}
+	return entries, nil
+}
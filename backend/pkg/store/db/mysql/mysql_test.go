@@ -0,0 +1,120 @@
+package mysql
+
+import (
+	"database/sql"
+	"io"
+	"log/slog"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// newTestMySQL は空のスキーマの fakeDriver に接続した MySQL を作る
+func newTestMySQL(t *testing.T) *MySQL {
+	t.Helper()
+	db, err := sql.Open("fakemysql", t.Name())
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return &MySQL{lg: slog.New(slog.NewTextHandler(io.Discard, nil)), db: db}
+}
+
+// testSchema は newTestMySQL が使うスキーマを返す
+func testSchema(t *testing.T) *fakeSchema {
+	t.Helper()
+	testDriver.mu.Lock()
+	defer testDriver.mu.Unlock()
+	return testDriver.schemas[t.Name()]
+}
+
+func TestInitEmptySchema(t *testing.T) {
+	s := newTestMySQL(t)
+	if err := s.Init(); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	// 二回目は何もしない
+	if err := s.Init(); err != nil {
+		t.Fatalf("Init() second call error = %v", err)
+	}
+
+	schema := testSchema(t)
+	var tables []string
+	for name := range schema.tables {
+		tables = append(tables, name)
+	}
+	slices.Sort(tables)
+	want := []string{
+		"attachments",
+		"author_aliases",
+		"author_records",
+		"authors",
+		"book_languages",
+		"books",
+		"cover_candidates",
+		"identifiers",
+		"provider_cache",
+		"subjects",
+	}
+	if !reflect.DeepEqual(tables, want) {
+		t.Errorf("tables = %v, want %v", tables, want)
+	}
+	if !slices.Contains(schema.tables["authors"].columns, "author_id") {
+		t.Errorf("authors columns = %v, want author_id", schema.tables["authors"].columns)
+	}
+}
+
+// 最初のリリースのテーブルから移行できる
+func TestInitBaselineSchema(t *testing.T) {
+	s := newTestMySQL(t)
+	for _, query := range []string{
+		`CREATE TABLE IF NOT EXISTS books(
+			isbn varchar(14) PRIMARY KEY,
+			title varchar(200),
+			description varchar(2000),
+			publishdate date,
+			language varchar(8),
+			image varchar(200),
+			deleted boolean DEFAULT false,
+			updated_time DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS authors(
+			id int AUTO_INCREMENT PRIMARY KEY,
+			isbn varchar(14),
+			author varchar(200),
+			deleted boolean DEFAULT false,
+			UNIQUE KEY isbn_author (isbn, author)
+		)`,
+	} {
+		if _, err := s.db.Exec(query); err != nil {
+			t.Fatalf("Exec() error = %v", err)
+		}
+	}
+	if err := s.Init(); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	if err := s.Init(); err != nil {
+		t.Fatalf("Init() second call error = %v", err)
+	}
+
+	schema := testSchema(t)
+	authors := schema.tables["authors"]
+	var indexes []string
+	for name := range authors.indexes {
+		indexes = append(indexes, name)
+	}
+	slices.Sort(indexes)
+	if want := []string{"PRIMARY", "author_id", "book_author"}; !reflect.DeepEqual(indexes, want) {
+		t.Errorf("authors indexes = %v, want %v", indexes, want)
+	}
+	if want := []string{"book_id", "author", "role"}; !reflect.DeepEqual(authors.indexes["book_author"].columns, want) {
+		t.Errorf("book_author columns = %v, want %v", authors.indexes["book_author"].columns, want)
+	}
+	books := schema.tables["books"]
+	if want := []string{"id"}; !reflect.DeepEqual(books.indexes["PRIMARY"].columns, want) {
+		t.Errorf("books primary key = %v, want %v", books.indexes["PRIMARY"].columns, want)
+	}
+	if slices.Contains(books.columns, "language") {
+		t.Error("books.language is not dropped")
+	}
+}
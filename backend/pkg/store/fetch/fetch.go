@@ -0,0 +1,217 @@
+package storefetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	storeconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/config"
+	storeimage "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/image"
+)
+
+const (
+	defaultMaxBytes = 10 << 20
+	defaultTimeout  = 15 * time.Second
+	maxRedirects    = 3
+)
+
+// DefaultAllowedHosts は組み込みのプロバイダーが表紙の URL に使うホスト
+// Open Library の表紙は archive.org にリダイレクトされる
+var DefaultAllowedHosts = []string{
+	"ndlsearch.ndl.go.jp",
+	"cover.openbd.jp",
+	"books.google.com",
+	"books.googleusercontent.com",
+	"covers.openlibrary.org",
+	"archive.org",
+}
+
+var (
+	ErrHostNotAllowed = errors.New("host is not allowed")
+	ErrAddrNotAllowed = errors.New("address is not allowed")
+	ErrTooLarge       = errors.New("image is too large")
+)
+
+// blockedPrefixes は IsPrivate などで判定できない取得させないアドレス
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+// Image は取得した画像
+type Image struct {
+	Data []byte
+	// ContentType はレスポンスのヘッダーではなく中身から判定した形式
+	ContentType string
+}
+
+// Fetcher はプロバイダーが返した表紙の URL から画像を取得する
+// 許可したホストだけに接続し、内部のアドレスには接続しない
+type Fetcher struct {
+	lg       *slog.Logger
+	client   *http.Client
+	hosts    []string
+	maxBytes int64
+	// dial は接続先のアドレスを確かめてから接続する (テストでは差し替える)
+	dial func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+func NewFetcher(lg *slog.Logger, config storeconfig.FetchConfig) *Fetcher {
+	f := &Fetcher{
+		lg:       lg.With(slog.String("Package", "fetch")),
+		maxBytes: config.MaxBytes,
+	}
+	if f.maxBytes <= 0 {
+		f.maxBytes = defaultMaxBytes
+	}
+	for _, host := range append(append([]string{}, DefaultAllowedHosts...), config.AllowedHosts...) {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			f.hosts = append(f.hosts, host)
+		}
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		// 名前解決した後の実際の接続先を確かめるので DNS rebinding でも内部には届かない
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			addr, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			if !AllowedAddr(addr) {
+				return fmt.Errorf("%w: %s", ErrAddrNotAllowed, addr)
+			}
+			return nil
+		},
+	}
+	f.dial = dialer.DialContext
+	f.client = &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// プロキシを通すと接続先のアドレスを確かめられない
+			Proxy: nil,
+			DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+				return f.dial(ctx, network, address)
+			},
+			TLSHandshakeTimeout:   5 * time.Second,
+			ResponseHeaderTimeout: 10 * time.Second,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			return f.checkURL(req.URL)
+		},
+	}
+	return f
+}
+
+// Fetch は u の画像を取得する
+// JPEG, PNG, GIF, WebP 以外や MaxBytes を超える画像はエラーにする
+func (f *Fetcher) Fetch(ctx context.Context, u url.URL) (*Image, error) {
+	if err := f.checkURL(&u); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "image/webp,image/png,image/jpeg,image/gif")
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get image: %w", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			f.lg.Error("failed to close responce")
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad status: %s", resp.Status)
+	}
+	if resp.ContentLength > f.maxBytes {
+		return nil, fmt.Errorf("%w: %d bytes", ErrTooLarge, resp.ContentLength)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, f.maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	if int64(len(data)) > f.maxBytes {
+		return nil, fmt.Errorf("%w: over %d bytes", ErrTooLarge, f.maxBytes)
+	}
+	ct, err := storeimage.Sniff(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Image{Data: data, ContentType: ct}, nil
+}
+
+// checkURL は http か https で許可したホストの URL だけを通す
+func (f *Fetcher) checkURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme: %q", u.Scheme)
+	}
+	if u.User != nil {
+		return fmt.Errorf("url must not contain userinfo")
+	}
+	if !f.AllowedHost(u.Hostname()) {
+		return fmt.Errorf("%w: %s", ErrHostNotAllowed, u.Hostname())
+	}
+	return nil
+}
+
+// AllowedHost は host が許可したホストかそのサブドメインかを返す
+func (f *Fetcher) AllowedHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "" {
+		return false
+	}
+	for _, allowed := range f.hosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedAddr は addr がインターネット上のアドレスかを返す
+// ループバック、プライベート、リンクローカル (クラウドのメタデータを含む) などは許さない
+func AllowedAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
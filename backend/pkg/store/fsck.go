@@ -0,0 +1,182 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+	storeimage "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/image"
+)
+
+// FsckReport は Fsck で見つかった DB と ObjectStore の不整合
+type FsckReport struct {
+	// OrphanImages は登録されていないか削除された本の画像
+	OrphanImages []string
+	// MissingImages は表紙が無いか、索引にあっても画像が無い本
+	MissingImages []string
+	// MissingVariants は表紙はあるが縮小画像が無い本と写真 ("<本の id>_<写真の id>")
+	MissingVariants []string
+	// MissingAttachments は DB にあるが画像が無い写真 ("<本の id>_<写真の id>")
+	MissingAttachments []string
+
+	Repaired int
+	Failed   int
+}
+
+// Problems は見つかった不整合の数を返す
+func (r *FsckReport) Problems() int {
+	return len(r.OrphanImages) + len(r.MissingImages) + len(r.MissingVariants) + len(r.MissingAttachments)
+}
+
+// Fsck は DB と ObjectStore を突き合わせて不整合を探す
+// repair の場合は孤立した画像を消し、無い表紙は取り直すか仮の表紙を作り、無い縮小画像は作り直す
+// 画像が無い写真は取り直せないので DB から消す
+func (s *BookStore) Fsck(repair bool) (*FsckReport, error) {
+	books, err := s.db.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get info in db: %w", err)
+	}
+	ids, err := s.object.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list images in object: %w", err)
+	}
+
+	report := &FsckReport{}
+	live := make(map[string]bool, len(books))
+	attachments := make(map[string][]bookscommon.Attachment, len(books))
+	for _, book := range books {
+		live[book.ID] = true
+		attachments[book.ID], err = s.db.GetAttachments(book.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get attachments in db: %w", err)
+		}
+		for _, attachment := range attachments[book.ID] {
+			live[attachmentObjectID(book.ID, attachment.ID)] = true
+		}
+	}
+	for _, id := range ids {
+		if live[id] {
+			continue
+		}
+		report.OrphanImages = append(report.OrphanImages, id)
+		if repair {
+			s.countRepair(report, id, s.object.Delete(id))
+		}
+	}
+
+	for _, book := range books {
+		missing, missingVariants, err := s.checkImage(book.ID)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case missing:
+			report.MissingImages = append(report.MissingImages, book.ID)
+			if repair {
+				s.countRepair(report, book.ID, s.repairImage(book))
+			}
+		case missingVariants:
+			report.MissingVariants = append(report.MissingVariants, book.ID)
+			if repair {
+				s.countRepair(report, book.ID, s.processImage(book.ID, bookscommon.Image{Origin: book.Image.Origin}))
+			}
+		}
+
+		for _, attachment := range attachments[book.ID] {
+			objectID := attachmentObjectID(book.ID, attachment.ID)
+			missing, missingVariants, err := s.checkImage(objectID)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case missing:
+				report.MissingAttachments = append(report.MissingAttachments, objectID)
+				if repair {
+					s.countRepair(report, objectID, s.db.DeleteAttachment(book.ID, attachment.ID))
+				}
+			case missingVariants:
+				report.MissingVariants = append(report.MissingVariants, objectID)
+				if repair {
+					s.countRepair(report, objectID, s.processAttachment(objectID))
+				}
+			}
+		}
+	}
+	return report, nil
+}
+
+func (s *BookStore) countRepair(report *FsckReport, id string, err error) {
+	if err != nil {
+		s.lg.Warn("failed to repair", slog.String("id", id), slog.String("err", err.Error()))
+		report.Failed++
+		return
+	}
+	report.Repaired++
+}
+
+// checkImage は id の画像 (本の表紙か写真) と縮小画像が開けるかを確かめる
+// 仮の表紙 (SVG) には縮小画像が無いので確かめない
+func (s *BookStore) checkImage(id string) (missing bool, missingVariants bool, err error) {
+	key, err := s.object.Get(id)
+	if err != nil {
+		return false, false, fmt.Errorf("failed to get image in object: %w", err)
+	}
+	if key == "" {
+		return true, false, nil
+	}
+	exists, err := s.imageExists(key)
+	if err != nil || !exists {
+		return !exists, false, err
+	}
+	if path.Ext(key) == storecommon.ImageExt(storeimage.PlaceholderContentType) {
+		return false, false, nil
+	}
+	for _, size := range storeimage.Sizes {
+		for _, format := range []string{storeimage.WebP, storeimage.JPEG} {
+			exists, err := s.imageExists(storecommon.VariantKey(key, storeimage.VariantName(size, format)))
+			if err != nil || !exists {
+				return false, !exists, err
+			}
+		}
+	}
+	return false, false, nil
+}
+
+func (s *BookStore) imageExists(key string) (bool, error) {
+	image, err := s.object.Open(key)
+	if errors.Is(err, storecommon.ErrNotFoundImage) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to open image in object: %w", err)
+	}
+	if err := image.Close(); err != nil {
+		s.lg.Error("failed to close image", slog.String("err", err.Error()))
+	}
+	return true, nil
+}
+
+// repairImage は表紙の無い本の表紙をプロバイダーの URL から取り直す
+// 取れない場合や利用者がアップロードした表紙が失われた場合は仮の表紙を作る
+func (s *BookStore) repairImage(book bookscommon.Info) error {
+	if book.Image.Origin != bookscommon.ImageUser && book.Image.Source.String() != "" {
+		image, err := s.fetcher.Fetch(context.Background(), book.Image.Source)
+		if err == nil {
+			if err := s.object.Upload(book.ID, bytes.NewReader(image.Data), image.ContentType); err != nil {
+				return fmt.Errorf("failed to put image in object: %w", err)
+			}
+			// 選んだ表紙は選んだまま取り直す
+			origin := bookscommon.ImageProvider
+			if book.Image.Origin == bookscommon.ImageSelected {
+				origin = bookscommon.ImageSelected
+			}
+			return s.processImage(book.ID, bookscommon.Image{Origin: origin})
+		}
+		s.lg.Warn("failed to fetch image", slog.String("id", book.ID), slog.String("err", err.Error()))
+	}
+	return s.putPlaceholder(book)
+}
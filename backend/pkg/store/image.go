@@ -0,0 +1,148 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+	storeimage "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/image"
+)
+
+// putPlaceholder は表紙の無い本に書名と著者を書いた仮の表紙を保存する
+// 背景色は ISBN (無い場合は ID) から決める
+func (s *BookStore) putPlaceholder(info bookscommon.Info) error {
+	seed := info.ISBN
+	if seed == "" {
+		seed = info.ID
+	}
+	placeholder, err := storeimage.NewPlaceholder(info.Title, strings.Join(info.AuthorNames(), ", "), seed)
+	if err != nil {
+		return fmt.Errorf("failed to create placeholder: %w", err)
+	}
+	backup, err := s.backupImage(info.ID)
+	if err != nil {
+		return err
+	}
+	if err := s.object.Upload(info.ID, bytes.NewReader(placeholder.Data), storeimage.PlaceholderContentType); err != nil {
+		return fmt.Errorf("failed to put placeholder in object: %w", err)
+	}
+	image := bookscommon.Image{Color: placeholder.Color, Blurhash: placeholder.Blurhash, Origin: bookscommon.ImageGenerated}
+	if err := s.db.PutImageMeta(info.ID, image); err != nil {
+		s.restoreImage(info.ID, backup)
+		return fmt.Errorf("failed to put image meta in db: %w", err)
+	}
+	return nil
+}
+
+// ensurePlaceholder は id の本に表紙が無いか仮の表紙の場合に仮の表紙を作る
+func (s *BookStore) ensurePlaceholder(id string) error {
+	info, err := s.db.Get(id)
+	if err != nil {
+		return fmt.Errorf("failed to get info in db: %w", err)
+	}
+	key, err := s.object.Get(id)
+	if err != nil {
+		return fmt.Errorf("failed to get image in object: %w", err)
+	}
+	if key != "" && info.Image.Origin != bookscommon.ImageGenerated {
+		return nil
+	}
+	return s.putPlaceholder(info)
+}
+
+// imageBackup は書き換える前の表紙 (表紙が無かった場合は nil)
+type imageBackup struct {
+	data        []byte
+	contentType string
+}
+
+// backupImage は表紙を書き換える前に元の画像を読んでおく
+func (s *BookStore) backupImage(id string) (*imageBackup, error) {
+	key, err := s.object.Get(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get image in object: %w", err)
+	}
+	if key == "" {
+		return nil, nil
+	}
+	original, err := s.object.Open(key)
+	if errors.Is(err, storecommon.ErrNotFoundImage) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open image in object: %w", err)
+	}
+	defer func() {
+		if err := original.Close(); err != nil {
+			s.lg.Error("failed to close image", slog.String("err", err.Error()))
+		}
+	}()
+	data, err := io.ReadAll(original)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image in object: %w", err)
+	}
+	return &imageBackup{data: data, contentType: original.ContentType}, nil
+}
+
+// restoreImage は書き換えた表紙を backup に戻す (backup が nil の場合は消す)
+// 縮小画像は戻さないので、必要なら fsck で作り直す
+func (s *BookStore) restoreImage(id string, backup *imageBackup) {
+	var err error
+	if backup == nil {
+		err = s.object.Delete(id)
+	} else {
+		err = s.object.Upload(id, bytes.NewReader(backup.data), backup.contentType)
+	}
+	if err != nil {
+		s.lg.Error("failed to restore image", slog.String("id", id), slog.String("err", err.Error()))
+	}
+}
+
+// processImage は id の表紙から縮小画像を作って保存し、代表色と blurhash を meta の取得元などと一緒に記録する
+func (s *BookStore) processImage(id string, meta bookscommon.Image) error {
+	key, err := s.object.Get(id)
+	if err != nil {
+		return fmt.Errorf("failed to get image in object: %w", err)
+	}
+	if key == "" {
+		return nil
+	}
+	result, err := s.putVariants(id, key)
+	if err != nil {
+		return err
+	}
+	meta.Color, meta.Blurhash = result.Color, result.Blurhash
+	if err := s.db.PutImageMeta(id, meta); err != nil {
+		return fmt.Errorf("failed to put image meta in db: %w", err)
+	}
+	return nil
+}
+
+// putVariants は key の画像から縮小画像を作って id の派生画像として保存する
+// 表紙と本に付けた写真で使う
+func (s *BookStore) putVariants(id, key string) (*storeimage.Result, error) {
+	original, err := s.object.Open(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open image in object: %w", err)
+	}
+	defer func() {
+		if err := original.Close(); err != nil {
+			s.lg.Error("failed to close image", slog.String("err", err.Error()))
+		}
+	}()
+
+	result, err := storeimage.Process(original)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process image: %w", err)
+	}
+	for _, v := range result.Variants {
+		if err := s.object.PutVariant(id, v.Name(), bytes.NewReader(v.Data), v.ContentType); err != nil {
+			return nil, fmt.Errorf("failed to put variant %s in object: %w", v.Name(), err)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,216 @@
+package storeimage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"net/http"
+	"strconv"
+
+	// 表紙として届く形式のデコーダーを登録する
+	_ "image/gif"
+	_ "image/png"
+
+	"github.com/HugoSmits86/nativewebp"
+	"github.com/buckket/go-blurhash"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// Sizes は生成する縮小画像の長辺のピクセル数
+var Sizes = []int{128, 256, 512}
+
+// 縮小画像の形式
+const (
+	WebP = "webp"
+	JPEG = "jpeg"
+)
+
+var contentTypes = map[string]string{
+	WebP: "image/webp",
+	JPEG: "image/jpeg",
+}
+
+const jpegQuality = 85
+
+// MaxPixels はデコードする画像の画素数 (幅×高さ) の上限
+// 小さなファイルから巨大な画像に展開される画像でメモリを使い果たさないようにする
+const MaxPixels = 50_000_000
+
+var ErrTooManyPixels = errors.New("image has too many pixels")
+
+// Variant は縮小画像一つ分
+type Variant struct {
+	Size        int
+	Format      string
+	ContentType string
+	Data        []byte
+}
+
+// Name は ObjectStore に保存するときの名前 ("256.webp" など)
+func (v Variant) Name() string {
+	return VariantName(v.Size, v.Format)
+}
+
+func VariantName(size int, format string) string {
+	return strconv.Itoa(size) + "." + format
+}
+
+// Result は表紙から作ったもの
+type Result struct {
+	Variants []Variant
+	// Color は代表色 ("#rrggbb")
+	Color    string
+	Blurhash string
+}
+
+// CheckPixels は width×height の画像をデコードしてよいかを返す
+func CheckPixels(width, height int) error {
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("image is empty")
+	}
+	if int64(width)*int64(height) > MaxPixels {
+		return fmt.Errorf("%w: %dx%d", ErrTooManyPixels, width, height)
+	}
+	return nil
+}
+
+// Process は r の画像から Sizes の各大きさの WebP と JPEG、代表色、blurhash を作る
+// 元の画像より大きくはしない
+// デコードする前にヘッダーの大きさを確かめ、MaxPixels を超える画像はエラーにする
+func Process(r io.ReadSeeker) (*Result, error) {
+	config, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image config: %w", err)
+	}
+	if err := CheckPixels(config.Width, config.Height); err != nil {
+		return nil, err
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to seek image: %w", err)
+	}
+	src, _, err := image.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	result := &Result{}
+	for _, size := range Sizes {
+		img := resize(src, size)
+		for _, format := range []string{WebP, JPEG} {
+			var buf bytes.Buffer
+			switch format {
+			case WebP:
+				err = nativewebp.Encode(&buf, img, nil)
+			case JPEG:
+				err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode %s: %w", format, err)
+			}
+			result.Variants = append(result.Variants, Variant{
+				Size:        size,
+				Format:      format,
+				ContentType: contentTypes[format],
+				Data:        buf.Bytes(),
+			})
+		}
+	}
+
+	// 代表色と blurhash は小さい画像から求めれば十分
+	small := resize(src, 32)
+	result.Color = dominantColor(small)
+	result.Blurhash, err = blurhash.Encode(4, 3, small)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode blurhash: %w", err)
+	}
+	return result, nil
+}
+
+// resize は長辺が size 以下になるように縮小する
+// JPEG に透過が無いので背景は白にする
+func resize(src image.Image, size int) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if longest := max(w, h); longest > size {
+		w, h = max(1, w*size/longest), max(1, h*size/longest)
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Over, nil)
+	return dst
+}
+
+// dominantColor は色を各 4 ビットに丸めて最も多い色の平均を返す
+func dominantColor(img *image.RGBA) string {
+	type sum struct{ r, g, b, n int }
+	buckets := make(map[int]*sum)
+	var best *sum
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := img.RGBAAt(x, y)
+			key := int(c.R>>4)<<8 | int(c.G>>4)<<4 | int(c.B>>4)
+			s, ok := buckets[key]
+			if !ok {
+				s = &sum{}
+				buckets[key] = s
+			}
+			s.r, s.g, s.b, s.n = s.r+int(c.R), s.g+int(c.G), s.b+int(c.B), s.n+1
+			if best == nil || s.n > best.n {
+				best = s
+			}
+		}
+	}
+	if best == nil {
+		return ""
+	}
+	c := color.RGBA{R: uint8(best.r / best.n), G: uint8(best.g / best.n), B: uint8(best.b / best.n)}
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// sniffTypes は表紙として受け付ける形式
+var sniffTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// Sniff は data の先頭のバイト列から画像の形式を判定する
+// 表紙として受け付けない形式の場合はエラーを返す
+func Sniff(data []byte) (string, error) {
+	ct := http.DetectContentType(data)
+	if !sniffTypes[ct] {
+		return "", fmt.Errorf("unsupported image type: %s", ct)
+	}
+	return ct, nil
+}
+
+// Size は data の画像の大きさをデコードせずに返す
+func Size(data []byte) (int, int, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to decode image config: %w", err)
+	}
+	return config.Width, config.Height, nil
+}
+
+// CheckSize は data の画像をデコードせずに大きさを確かめ、MaxPixels を超える場合はエラーを返す
+// アップロードされた画像を保存する前に使う
+func CheckSize(data []byte) error {
+	width, height, err := Size(data)
+	if err != nil {
+		return err
+	}
+	return CheckPixels(width, height)
+}
+
+// ContentType は縮小画像の形式の Content-Type を返す (知らない形式は空)
+func ContentType(format string) string {
+	return contentTypes[format]
+}
@@ -0,0 +1,80 @@
+package storeimage
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"testing"
+)
+
+func TestSniff(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 2, 2))); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    string
+		wantErr bool
+	}{
+		{name: "png", data: buf.Bytes(), want: "image/png"},
+		{name: "jpeg", data: []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00"), want: "image/jpeg"},
+		{name: "gif", data: []byte("GIF89a\x01\x00\x01\x00"), want: "image/gif"},
+		{name: "webp", data: []byte("RIFF\x24\x00\x00\x00WEBPVP8 "), want: "image/webp"},
+		{name: "html", data: []byte("<!DOCTYPE html><html><body><img src=x></body></html>"), wantErr: true},
+		{name: "svg", data: []byte(`<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`), wantErr: true},
+		{name: "svg with xml declaration", data: []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"/>`), wantErr: true},
+		{name: "empty", data: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Sniff(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Sniff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Sniff() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// bombGIF は 60000x60000 と申告するだけの GIF (画像の本体は無い)
+func bombGIF() []byte {
+	return []byte("GIF89a\x60\xea\x60\xea\x00\x00\x00;")
+}
+
+func TestProcess(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 300, 200))); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+	result, err := Process(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	if want := len(Sizes) * 2; len(result.Variants) != want {
+		t.Errorf("len(Variants) = %d, want %d", len(result.Variants), want)
+	}
+	if result.Color == "" || result.Blurhash == "" {
+		t.Errorf("Color = %q, Blurhash = %q, want non-empty", result.Color, result.Blurhash)
+	}
+}
+
+func TestProcessTooManyPixels(t *testing.T) {
+	if _, err := Process(bytes.NewReader(bombGIF())); !errors.Is(err, ErrTooManyPixels) {
+		t.Errorf("Process() error = %v, want %v", err, ErrTooManyPixels)
+	}
+}
+
+func TestCheckSize(t *testing.T) {
+	if err := CheckSize(bombGIF()); !errors.Is(err, ErrTooManyPixels) {
+		t.Errorf("CheckSize() error = %v, want %v", err, ErrTooManyPixels)
+	}
+	if err := CheckSize([]byte("not an image")); err == nil {
+		t.Error("CheckSize() error = nil, want error")
+	}
+}
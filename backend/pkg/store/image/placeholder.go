@@ -0,0 +1,189 @@
+package storeimage
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"strings"
+	"unicode"
+
+	"github.com/buckket/go-blurhash"
+)
+
+// PlaceholderContentType は仮の表紙の形式
+// CJK のフォントを持たなくて済むように文字の描画はクライアントに任せる
+const PlaceholderContentType = "image/svg+xml"
+
+// 仮の表紙の大きさと文字の配置
+const (
+	placeholderWidth  = 400
+	placeholderHeight = 600
+	placeholderMargin = 32
+
+	titleFontSize  = 36
+	titleMaxLines  = 6
+	authorFontSize = 22
+	authorMaxLines = 2
+)
+
+const placeholderFont = `'Hiragino Sans', 'Noto Sans CJK JP', 'Noto Sans JP', 'Yu Gothic', sans-serif`
+
+// Placeholder は表紙の無い本の仮の表紙
+type Placeholder struct {
+	Data []byte
+	// Color は背景色 ("#rrggbb")
+	Color    string
+	Blurhash string
+}
+
+// NewPlaceholder は title と author を書いた仮の表紙を作る
+// 背景色は seed (ISBN など) から決めるので、同じ本からは同じ表紙ができる
+func NewPlaceholder(title, author, seed string) (*Placeholder, error) {
+	bg := placeholderColor(seed)
+	bgHex := fmt.Sprintf("#%02x%02x%02x", bg.R, bg.G, bg.B)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`,
+		placeholderWidth, placeholderHeight, placeholderWidth, placeholderHeight)
+	fmt.Fprintf(&buf, `<rect width="100%%" height="100%%" fill="%s"/>`, bgHex)
+	fmt.Fprintf(&buf, `<rect x="%d" y="%d" width="%d" height="2" fill="#ffffff" fill-opacity="0.6"/>`,
+		placeholderMargin, placeholderMargin*2, placeholderWidth-placeholderMargin*2)
+
+	fmt.Fprintf(&buf, `<g font-family="%s" fill="#ffffff">`, html.EscapeString(placeholderFont))
+	lines := wrapText(title, lineWidth(titleFontSize), titleMaxLines)
+	for i, line := range lines {
+		fmt.Fprintf(&buf, `<text x="%d" y="%d" font-size="%d" font-weight="bold">%s</text>`,
+			placeholderMargin, placeholderMargin*2+titleFontSize*(i+2), titleFontSize, html.EscapeString(line))
+	}
+	lines = wrapText(author, lineWidth(authorFontSize), authorMaxLines)
+	for i, line := range lines {
+		y := placeholderHeight - placeholderMargin*2 - authorFontSize*3/2*(len(lines)-1-i)
+		fmt.Fprintf(&buf, `<text x="%d" y="%d" font-size="%d">%s</text>`,
+			placeholderMargin, y, authorFontSize, html.EscapeString(line))
+	}
+	buf.WriteString(`</g></svg>`)
+
+	// 単色なので blurhash は背景色だけの画像から求める
+	img := image.NewRGBA(image.Rect(0, 0, 4, 6))
+	for y := range 6 {
+		for x := range 4 {
+			img.SetRGBA(x, y, bg)
+		}
+	}
+	hash, err := blurhash.Encode(4, 3, img)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode blurhash: %w", err)
+	}
+	return &Placeholder{
+		Data:     buf.Bytes(),
+		Color:    bgHex,
+		Blurhash: hash,
+	}, nil
+}
+
+// placeholderColor は seed から白い文字が読める濃さの色を決める
+func placeholderColor(seed string) color.RGBA {
+	sum := sha256.Sum256([]byte(seed))
+	hue := float64(binary.BigEndian.Uint16(sum[:2])%360) / 360
+	return hslToRGB(hue, 0.45, 0.35)
+}
+
+func hslToRGB(h, s, l float64) color.RGBA {
+	var q float64
+	if l < 0.5 {
+		q = l * (1 + s)
+	} else {
+		q = l + s - l*s
+	}
+	p := 2*l - q
+	channel := func(t float64) uint8 {
+		switch {
+		case t < 0:
+			t++
+		case t > 1:
+			t--
+		}
+		var v float64
+		switch {
+		case t < 1.0/6:
+			v = p + (q-p)*6*t
+		case t < 1.0/2:
+			v = q
+		case t < 2.0/3:
+			v = p + (q-p)*(2.0/3-t)*6
+		default:
+			v = p
+		}
+		return uint8(v*255 + 0.5)
+	}
+	return color.RGBA{R: channel(h + 1.0/3), G: channel(h), B: channel(h - 1.0/3), A: 0xff}
+}
+
+// lineWidth は fontSize の文字で一行に入る幅 (半角の数)
+// 半角の文字幅を平均 0.55em として見積もる
+func lineWidth(fontSize int) int {
+	return (placeholderWidth - placeholderMargin*2) * 100 / (fontSize * 55)
+}
+
+// wrapText は text を一行 width 以内の行に分ける
+// 幅は半角を 1、全角を 2 として数え、英文はなるべく空白で折り返す
+// maxLines を超える分は最後の行を "…" で切る
+func wrapText(text string, width, maxLines int) []string {
+	var lines []string
+	var line []rune
+	used, lastSpace := 0, -1
+	for _, r := range strings.Join(strings.Fields(text), " ") {
+		w := runeWidth(r)
+		if used+w > width && len(line) > 0 {
+			rest := []rune{}
+			if lastSpace > 0 && !unicode.IsSpace(r) {
+				rest = append(rest, line[lastSpace+1:]...)
+				line = line[:lastSpace]
+			}
+			lines = append(lines, strings.TrimSpace(string(line)))
+			line, used, lastSpace = rest, 0, -1
+			for _, r := range rest {
+				used += runeWidth(r)
+			}
+			if unicode.IsSpace(r) {
+				continue
+			}
+		}
+		if unicode.IsSpace(r) {
+			lastSpace = len(line)
+		}
+		line = append(line, r)
+		used += w
+	}
+	if s := strings.TrimSpace(string(line)); s != "" {
+		lines = append(lines, s)
+	}
+	if len(lines) > maxLines {
+		last := []rune(lines[maxLines-1])
+		for len(last) > 0 && stringWidth(string(last))+2 > width {
+			last = last[:len(last)-1]
+		}
+		lines = append(lines[:maxLines-1], string(last)+"…")
+	}
+	return lines
+}
+
+func runeWidth(r rune) int {
+	if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) ||
+		(r >= 0x3000 && r <= 0x303f) || (r >= 0xff00 && r <= 0xff60) {
+		return 2
+	}
+	return 1
+}
+
+func stringWidth(s string) int {
+	n := 0
+	for _, r := range s {
+		n += runeWidth(r)
+	}
+	return n
+}
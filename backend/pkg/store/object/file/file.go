@@ -1,109 +1,233 @@
 package filestore
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
-	"net/http"
-	"net/url"
 	"os"
-	"path"
+	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
 	storeconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/config"
 )
 
+// FileStore は画像を prefix 以下に id のハッシュで分けたディレクトリに置く
+// id と画像のキーの対応は prefix/index.json の索引で管理する
 type FileStore struct {
 	lg     *slog.Logger
 	prefix string
+
+	mu sync.Mutex
+	// entries は id ごとの画像、keys はキーから id を引く
+	entries map[string]Entry
+	keys    map[string]string
 }
 
 func NewFileStore(lg *slog.Logger, config storeconfig.FileConfig) (*FileStore, error) {
-	return &FileStore{
+	s := &FileStore{
 		prefix: config.Prefix,
 		lg:     lg.With(slog.String("Package", "filesystem")),
-	}, nil
+	}
+	if err := s.load(); err != nil {
+		return nil, fmt.Errorf("failed to load index: %w", err)
+	}
+	return s, nil
 }
 
 func (s *FileStore) Close() error {
 	return nil
 }
 
-func (s *FileStore) Put(url url.URL, isbn string) error {
-	if url.String() == "" {
-		return nil
+// Upload は r の画像を id の表紙として保存する
+func (s *FileStore) Upload(id string, r io.Reader, ct string) error {
+	if err := storecommon.ValidateID(id); err != nil {
+		return err
+	}
+	ext := storecommon.ImageExt(ct)
+	if ext == "" {
+		return fmt.Errorf("unsupported content type: %q", ct)
 	}
-	resp, err := http.Get(url.String())
+	key := shardKey(id, ext)
+	hash, size, err := s.writeAtomic(key, r)
 	if err != nil {
-		return fmt.Errorf("failed to get image: %w", err)
+		return err
 	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			s.lg.Error("failed to close responce")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// 拡張子が変わった場合は古い画像を消し、古い画像から作った派生画像も消す
+	if old, ok := s.entries[id]; ok && old.Key != key {
+		if err := s.remove(old.Key); err != nil {
+			return err
 		}
-	}()
+		delete(s.keys, old.Key)
+	}
+	for name, entry := range s.entries {
+		if !strings.HasPrefix(name, id+"@") {
+			continue
+		}
+		if err := s.remove(entry.Key); err != nil {
+			return err
+		}
+		delete(s.entries, name)
+		delete(s.keys, entry.Key)
+	}
+	s.entries[id] = Entry{
+		Key:         key,
+		ContentType: ct,
+		SHA256:      hash,
+		Size:        size,
+		UpdatedAt:   time.Now(),
+	}
+	s.keys[key] = id
+	return s.save()
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("bad status: %s", resp.Status)
-	}
-
-	ct := resp.Header.Get("Content-Type")
-	var ext string
-	switch ct {
-	case "image/jpeg":
-		ext = ".jpeg"
-	case "image/png":
-		ext = ".png"
-	case "image/gif":
-		ext = ".gif"
-	case "image/webp":
-		ext = ".webp"
-	default:
-		ext = ""
-	}
-	path := path.Join(s.prefix, isbn) + ext
-	out, err := os.Create(path)
-	if err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
+// PutVariant は派生画像を元の画像と同じディレクトリに "<id>@<variant>" で保存する
+func (s *FileStore) PutVariant(id, variant string, r io.Reader, contentType string) error {
+	if err := storecommon.ValidateID(id); err != nil {
+		return err
 	}
-	_, err = io.Copy(out, resp.Body)
+	if err := storecommon.ValidateVariant(variant); err != nil {
+		return err
+	}
+	key := shardKey(id, "@"+variant)
+	hash, size, err := s.writeAtomic(key, r)
 	if err != nil {
-		return fmt.Errorf("failed to write file: %w", err)
+		return err
 	}
 
-	return nil
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[id+"@"+variant] = Entry{
+		Key:         key,
+		ContentType: contentType,
+		SHA256:      hash,
+		Size:        size,
+		UpdatedAt:   time.Now(),
+	}
+	s.keys[key] = id + "@" + variant
+	return s.save()
 }
 
-func (s *FileStore) Get(isbn string) (string, error) {
-	entries, err := os.ReadDir(s.prefix)
-	if err != nil {
-		return "", fmt.Errorf("failed to read dir: %w", err)
-	}
+// Get は画像のキー (prefix からの相対パス) を返す
+func (s *FileStore) Get(id string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.entries[id].Key, nil
+}
 
-	for _, entry := range entries {
-		if !entry.IsDir() && strings.Contains(entry.Name(), isbn) {
-			return entry.Name(), nil
-		}
+// Open は Get が返したキーの画像を開く
+// 索引に無いキーは開かない
+func (s *FileStore) Open(name string) (*storecommon.Object, error) {
+	s.mu.Lock()
+	id, ok := s.keys[name]
+	entry := s.entries[id]
+	s.mu.Unlock()
+	if !ok {
+		return nil, storecommon.ErrNotFoundImage
+	}
+	f, err := os.Open(filepath.Join(s.prefix, filepath.FromSlash(name)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, storecommon.ErrNotFoundImage
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
 	}
-	return "", nil
+	return &storecommon.Object{
+		ReadSeekCloser: f,
+		ContentType:    entry.ContentType,
+		ModTime:        entry.UpdatedAt,
+		ETag:           entry.SHA256,
+	}, nil
 }
 
-func (s *FileStore) Delete(isbn string) error {
-	entries, err := os.ReadDir(s.prefix)
-	if err != nil {
-		return fmt.Errorf("failed to read dir: %w", err)
+func (s *FileStore) Delete(id string) error {
+	if err := storecommon.ValidateID(id); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var deleted bool
+	for name, entry := range s.entries {
+		if name != id && !strings.HasPrefix(name, id+"@") {
+			continue
+		}
+		if err := s.remove(entry.Key); err != nil {
+			return err
+		}
+		delete(s.entries, name)
+		delete(s.keys, entry.Key)
+		deleted = true
+	}
+	if !deleted {
+		return nil
 	}
+	return s.save()
+}
 
-	for _, entry := range entries {
-		if !entry.IsDir() && strings.Contains(entry.Name(), isbn) {
-			path := path.Join(s.prefix, entry.Name())
-			if err := os.Remove(path); err != nil {
-				return fmt.Errorf("failed to delete file %s: %w", path, err)
-			} else {
-				return nil
-			}
+// List は索引にある元の画像の id を返す
+func (s *FileStore) List() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	for id := range s.entries {
+		if !strings.Contains(id, "@") {
+			ids = append(ids, id)
 		}
 	}
+	sort.Strings(ids)
+	return ids, nil
+}
 
+func (s *FileStore) remove(key string) error {
+	path := filepath.Join(s.prefix, filepath.FromSlash(key))
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete file %s: %w", path, err)
+	}
 	return nil
 }
+
+// writeAtomic は r を一時ファイルに書いてから key の場所に移す
+// 書き込みの途中で失敗しても壊れた画像が残らない
+func (s *FileStore) writeAtomic(key string, r io.Reader) (string, int64, error) {
+	path := filepath.Join(s.prefix, filepath.FromSlash(key))
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", 0, fmt.Errorf("failed to create dir: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create file: %w", err)
+	}
+	defer func() {
+		// rename 済みの場合は何もしない
+		if err := os.Remove(tmp.Name()); err != nil && !errors.Is(err, os.ErrNotExist) {
+			s.lg.Error("failed to remove temp file", slog.String("err", err.Error()))
+		}
+	}()
+
+	hash, size, err := copyWithHash(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return "", 0, fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return "", 0, fmt.Errorf("failed to sync file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", 0, fmt.Errorf("failed to close file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return "", 0, fmt.Errorf("failed to chmod file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", 0, fmt.Errorf("failed to rename file: %w", err)
+	}
+	return hash, size, nil
+}
@@ -0,0 +1,236 @@
+package filestore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+	storeconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/config"
+)
+
+// newTestStore は prefix を置き場所にした FileStore を作る
+func newTestStore(t *testing.T, prefix string) *FileStore {
+	t.Helper()
+	s, err := NewFileStore(slog.New(slog.NewTextHandler(io.Discard, nil)), storeconfig.FileConfig{Prefix: prefix})
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+	return s
+}
+
+// files は prefix 以下のファイルを prefix からの相対パスで返す
+func files(t *testing.T, prefix string) []string {
+	t.Helper()
+	var names []string
+	err := filepath.WalkDir(prefix, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(prefix, path)
+		if err != nil {
+			return err
+		}
+		names = append(names, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkDir() error = %v", err)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func readIndex(t *testing.T, prefix string) index {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join(prefix, indexName))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	var idx index
+	if err := json.Unmarshal(b, &idx); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	return idx
+}
+
+func TestShardKey(t *testing.T) {
+	tests := []struct {
+		id   string
+		ext  string
+		want string
+	}{
+		{"9784101001565", ".jpeg", "2e/9784101001565.jpeg"},
+		{"9784101001565", "@256.webp", "2e/9784101001565@256.webp"},
+		{"9784101010137", ".png", "7d/9784101010137.png"},
+	}
+	for _, tt := range tests {
+		if got := shardKey(tt.id, tt.ext); got != tt.want {
+			t.Errorf("shardKey(%q, %q) = %q, want %q", tt.id, tt.ext, got, tt.want)
+		}
+	}
+}
+
+func TestUploadReplacesExtension(t *testing.T) {
+	prefix := t.TempDir()
+	s := newTestStore(t, prefix)
+
+	if err := s.Upload("9784101001565", strings.NewReader("png"), "image/png"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if err := s.PutVariant("9784101001565", "256.webp", strings.NewReader("webp"), "image/webp"); err != nil {
+		t.Fatalf("PutVariant() error = %v", err)
+	}
+	if err := s.Upload("9784101001565", strings.NewReader("jpeg"), "image/jpeg"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	// 古い拡張子の画像と古い画像から作った派生画像は消える
+	want := []string{"2e/9784101001565.jpeg", "index.json"}
+	if got := files(t, prefix); !reflect.DeepEqual(got, want) {
+		t.Errorf("files = %v, want %v", got, want)
+	}
+	key, err := s.Get("9784101001565")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if key != "2e/9784101001565.jpeg" {
+		t.Errorf("Get() = %q, want %q", key, "2e/9784101001565.jpeg")
+	}
+
+	obj, err := s.Open(key)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer obj.Close()
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if string(data) != "jpeg" || obj.ContentType != "image/jpeg" {
+		t.Errorf("Open() = %q (%s), want %q (%s)", data, obj.ContentType, "jpeg", "image/jpeg")
+	}
+	if want := sha256Hex("jpeg"); obj.ETag != want {
+		t.Errorf("ETag = %q, want %q", obj.ETag, want)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	prefix := t.TempDir()
+	s := newTestStore(t, prefix)
+	if err := s.Upload("9784101001565", strings.NewReader("png"), "image/png"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if err := s.PutVariant("9784101001565", "128.jpeg", strings.NewReader("variant"), "image/jpeg"); err != nil {
+		t.Fatalf("PutVariant() error = %v", err)
+	}
+
+	idx := readIndex(t, prefix)
+	entry, ok := idx.Entries["9784101001565"]
+	if !ok {
+		t.Fatalf("index entries = %v, want 9784101001565", idx.Entries)
+	}
+	want := Entry{Key: "2e/9784101001565.png", ContentType: "image/png", SHA256: sha256Hex("png"), Size: 3}
+	if entry.UpdatedAt.IsZero() {
+		t.Error("UpdatedAt is zero")
+	}
+	got := entry
+	got.UpdatedAt = time.Time{}
+	if got != want {
+		t.Errorf("entry = %+v, want %+v", got, want)
+	}
+	if variant := idx.Entries["9784101001565@128.jpeg"]; variant.Key != "2e/9784101001565@128.jpeg" {
+		t.Errorf("variant key = %q, want %q", variant.Key, "2e/9784101001565@128.jpeg")
+	}
+
+	// 開き直すと保存した索引を読む
+	reopened := newTestStore(t, prefix)
+	if key, _ := reopened.Get("9784101001565"); key != entry.Key {
+		t.Errorf("Get() = %q, want %q", key, entry.Key)
+	}
+	ids, err := reopened.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if want := []string{"9784101001565"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("List() = %v, want %v", ids, want)
+	}
+}
+
+func TestDeleteKeepsPrefixSharingIDs(t *testing.T) {
+	prefix := t.TempDir()
+	s := newTestStore(t, prefix)
+
+	for _, id := range []string{"978410100156", "9784101001565", "9784101001565_2f1c7a4e"} {
+		if err := s.Upload(id, strings.NewReader(id), "image/jpeg"); err != nil {
+			t.Fatalf("Upload(%q) error = %v", id, err)
+		}
+		if err := s.PutVariant(id, "256.webp", strings.NewReader(id), "image/webp"); err != nil {
+			t.Fatalf("PutVariant(%q) error = %v", id, err)
+		}
+	}
+	if err := s.Delete("9784101001565"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	ids, err := s.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if want := []string{"978410100156", "9784101001565_2f1c7a4e"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("List() = %v, want %v", ids, want)
+	}
+	for _, key := range []string{shardKey("9784101001565", ".jpeg"), shardKey("9784101001565", "@256.webp")} {
+		if _, err := os.Stat(filepath.Join(prefix, filepath.FromSlash(key))); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("Stat(%q) error = %v, want not exist", key, err)
+		}
+	}
+	if err := s.Delete("../"); err == nil {
+		t.Error("Delete(\"../\") error = nil, want error")
+	}
+}
+
+// Open は索引にあるキーだけを開く
+func TestOpenNotFound(t *testing.T) {
+	prefix := t.TempDir()
+	s := newTestStore(t, prefix)
+	if err := s.Upload("9784101001565", strings.NewReader("png"), "image/png"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	// 索引に無いファイルは置いてあっても開かない
+	if err := os.WriteFile(filepath.Join(prefix, "2e", "9784101001565.jpeg"), []byte("stray"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(filepath.Dir(prefix), "secret.png"), []byte("secret"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	for _, name := range []string{"2e/9784101001565.jpeg", "9784101001565.png", "index.json", "../secret.png", ""} {
+		if _, err := s.Open(name); !errors.Is(err, storecommon.ErrNotFoundImage) {
+			t.Errorf("Open(%q) error = %v, want %v", name, err, storecommon.ErrNotFoundImage)
+		}
+	}
+
+	// 索引にあってもファイルが消えていれば見つからない
+	if err := os.Remove(filepath.Join(prefix, "2e", "9784101001565.png")); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if _, err := s.Open("2e/9784101001565.png"); !errors.Is(err, storecommon.ErrNotFoundImage) {
+		t.Errorf("Open() error = %v, want %v", err, storecommon.ErrNotFoundImage)
+	}
+}
@@ -0,0 +1,83 @@
+package filestore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// indexName は prefix 直下に置く索引のファイル名
+const indexName = "index.json"
+
+// Entry は索引に載っている画像一つ分
+type Entry struct {
+	// Key は prefix からの相対パス ("2e/9784101001565.jpeg" など)
+	Key         string    `json:"key"`
+	ContentType string    `json:"content_type"`
+	SHA256      string    `json:"sha256"`
+	Size        int64     `json:"size"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type index struct {
+	Entries map[string]Entry `json:"entries"`
+}
+
+// shardKey は id のハッシュの先頭 1 バイトをディレクトリにしたキーを返す
+// 一つのディレクトリにファイルが集中しないようにする
+func shardKey(id, ext string) string {
+	sum := sha256.Sum256([]byte(id))
+	return path.Join(hex.EncodeToString(sum[:1]), id+ext)
+}
+
+// load は索引を読む
+// 索引が無い場合は古い平置きのファイルを移行して作る
+func (s *FileStore) load() error {
+	s.entries = make(map[string]Entry)
+	s.keys = make(map[string]string)
+
+	b, err := os.ReadFile(filepath.Join(s.prefix, indexName))
+	if errors.Is(err, os.ErrNotExist) {
+		return s.migrate()
+	} else if err != nil {
+		return fmt.Errorf("failed to read index: %w", err)
+	}
+	var idx index
+	if err := json.Unmarshal(b, &idx); err != nil {
+		return fmt.Errorf("failed to decode index: %w", err)
+	}
+	for id, entry := range idx.Entries {
+		s.entries[id] = entry
+		s.keys[entry.Key] = id
+	}
+	return nil
+}
+
+// save は索引を書き出す (呼び出し側で mu を取る)
+func (s *FileStore) save() error {
+	b, err := json.MarshalIndent(index{Entries: s.entries}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode index: %w", err)
+	}
+	if _, _, err := s.writeAtomic(indexName, strings.NewReader(string(b))); err != nil {
+		return fmt.Errorf("failed to write index: %w", err)
+	}
+	return nil
+}
+
+func copyWithHash(w io.Writer, r io.Reader) (string, int64, error) {
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(w, h), r)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), size, nil
+}
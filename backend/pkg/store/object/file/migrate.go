@@ -0,0 +1,92 @@
+package filestore
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+)
+
+// migrate は prefix 直下に "<id><ext>" で平置きされていた画像をシャードしたディレクトリに移し、索引を作る
+func (s *FileStore) migrate() error {
+	dirEntries, err := os.ReadDir(s.prefix)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read dir: %w", err)
+	}
+
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if !dirEntry.Type().IsRegular() || strings.HasPrefix(name, ".") || name == indexName {
+			continue
+		}
+		ext := path.Ext(name)
+		id := strings.TrimSuffix(name, ext)
+		if err := storecommon.ValidateID(id); err != nil {
+			s.lg.Warn("skipped file with invalid id", slog.String("file", name))
+			continue
+		}
+		if old, ok := s.entries[id]; ok {
+			s.lg.Warn("skipped duplicate image", slog.String("file", name), slog.String("key", old.Key))
+			continue
+		}
+
+		src := filepath.Join(s.prefix, name)
+		f, err := os.Open(src)
+		if err != nil {
+			return fmt.Errorf("failed to open file: %w", err)
+		}
+		// 先頭は Content-Type の判定にも使う
+		head := make([]byte, 512)
+		n, err := io.ReadFull(f, head)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			f.Close()
+			return fmt.Errorf("failed to read file %s: %w", name, err)
+		}
+		head = head[:n]
+		hash, size, err := copyWithHash(io.Discard, io.MultiReader(bytes.NewReader(head), f))
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("failed to hash file %s: %w", name, err)
+		}
+		// 拡張子の無い古い画像は中身から判定する (nosniff で返すので空だと表示されない)
+		ct := mime.TypeByExtension(ext)
+		if ct == "" {
+			ct = http.DetectContentType(head)
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			return fmt.Errorf("failed to stat file %s: %w", name, err)
+		}
+
+		key := shardKey(id, ext)
+		dst := filepath.Join(s.prefix, filepath.FromSlash(key))
+		if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+			return fmt.Errorf("failed to create dir: %w", err)
+		}
+		if err := os.Rename(src, dst); err != nil {
+			return fmt.Errorf("failed to move file %s: %w", name, err)
+		}
+		s.entries[id] = Entry{
+			Key:         key,
+			ContentType: ct,
+			SHA256:      hash,
+			Size:        size,
+			UpdatedAt:   info.ModTime(),
+		}
+		s.keys[key] = id
+	}
+
+	if err := s.save(); err != nil {
+		return err
+	}
+	s.lg.Info("migrated images to indexed layout", slog.Int("count", len(s.entries)))
+	return nil
+}
@@ -0,0 +1,105 @@
+package filestore
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// pngHeader は PNG のシグネチャと IHDR の先頭
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// 平置きの古い画像はシャードしたディレクトリに移して索引に載せる
+func TestMigrate(t *testing.T) {
+	prefix := t.TempDir()
+	modTime := time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)
+	for name, data := range map[string][]byte{
+		"9784101001565.jpg": []byte("\xff\xd8\xff\xe0jpeg"),
+		// 拡張子の無い画像は中身から判定する
+		"9784101010137":  pngHeader,
+		".hidden":        []byte("hidden"),
+		"invalid id.png": pngHeader,
+	} {
+		path := filepath.Join(prefix, name)
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatalf("Chtimes() error = %v", err)
+		}
+	}
+
+	s := newTestStore(t, prefix)
+
+	want := []string{".hidden", "2e/9784101001565.jpg", "7d/9784101010137", "index.json", "invalid id.png"}
+	if got := files(t, prefix); !reflect.DeepEqual(got, want) {
+		t.Errorf("files = %v, want %v", got, want)
+	}
+	ids, err := s.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if want := []string{"9784101001565", "9784101010137"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("List() = %v, want %v", ids, want)
+	}
+
+	tests := []struct {
+		id          string
+		key         string
+		contentType string
+		data        []byte
+	}{
+		{"9784101001565", "2e/9784101001565.jpg", "image/jpeg", []byte("\xff\xd8\xff\xe0jpeg")},
+		{"9784101010137", "7d/9784101010137", "image/png", pngHeader},
+	}
+	idx := readIndex(t, prefix)
+	for _, tt := range tests {
+		key, err := s.Get(tt.id)
+		if err != nil {
+			t.Fatalf("Get(%q) error = %v", tt.id, err)
+		}
+		if key != tt.key {
+			t.Errorf("Get(%q) = %q, want %q", tt.id, key, tt.key)
+		}
+		want := Entry{
+			Key:         tt.key,
+			ContentType: tt.contentType,
+			SHA256:      sha256Hex(string(tt.data)),
+			Size:        int64(len(tt.data)),
+			UpdatedAt:   modTime,
+		}
+		got := idx.Entries[tt.id]
+		// JSON から読んだ時刻はタイムゾーンが違うので Equal で比べる
+		if got.UpdatedAt.Equal(modTime) {
+			got.UpdatedAt = modTime
+		}
+		if got != want {
+			t.Errorf("entry %q = %+v, want %+v", tt.id, got, want)
+		}
+
+		obj, err := s.Open(key)
+		if err != nil {
+			t.Fatalf("Open(%q) error = %v", key, err)
+		}
+		data, err := io.ReadAll(obj)
+		obj.Close()
+		if err != nil {
+			t.Fatalf("ReadAll() error = %v", err)
+		}
+		if string(data) != string(tt.data) || obj.ContentType != tt.contentType {
+			t.Errorf("Open(%q) = %q (%s), want %q (%s)", key, data, obj.ContentType, tt.data, tt.contentType)
+		}
+	}
+
+	// 索引ができた後は移行しない
+	if err := os.WriteFile(filepath.Join(prefix, "9780141182629.png"), pngHeader, 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	reopened := newTestStore(t, prefix)
+	if key, _ := reopened.Get("9780141182629"); key != "" {
+		t.Errorf("Get() = %q, want empty", key)
+	}
+}
@@ -2,20 +2,40 @@ package object
 
 import (
 	"fmt"
+	"io"
 	"log/slog"
-	"net/url"
 
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
 	storeconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/config"
 	filestore "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/object/file"
+	s3store "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/object/s3"
 )
 
 type ObjectStore interface {
-	Put(url url.URL, isbn string) error
-	Get(isbn string) (string, error)
-	Delete(isbn string) error
+	// Upload は r の画像を id の表紙として保存し、以前の画像と派生画像を消す
+	// 途中で失敗した場合は以前の画像が残る
+	Upload(id string, r io.Reader, contentType string) error
+	// Get は画像のキー (/images/ 以下のパス) を返す (無い場合は空)
+	Get(id string) (string, error)
+	// Open は Get が返したキーの画像を開く
+	// 無い場合は storecommon.ErrNotFoundImage を返す
+	Open(name string) (*storecommon.Object, error)
+	// PutVariant は id の画像から作った縮小画像などを variant ("256.webp" など) として保存する
+	// キーは storecommon.VariantKey で元の画像のキーから求められる
+	PutVariant(id, variant string, r io.Reader, contentType string) error
+	// Delete は id の画像と派生画像を消す
+	Delete(id string) error
+	// List は画像がある本の id を返す
+	List() ([]string, error)
 	Close() error
 }
 
+// Presigner は API を通さずに画像を直接取得できる URL を返せる ObjectStore
+// 使わない設定の場合は空を返す
+type Presigner interface {
+	PresignedURL(key string) (string, error)
+}
+
 func NewObjectStore(lg *slog.Logger, config storeconfig.ObjectConfig) (ObjectStore, error) {
 	switch config.Kind {
 	case storeconfig.FileSystem:
@@ -24,6 +44,12 @@ func NewObjectStore(lg *slog.Logger, config storeconfig.ObjectConfig) (ObjectSto
 			return nil, fmt.Errorf("failed to create file store: %w", err)
 		}
 		return filesystem, nil
+	case storeconfig.S3:
+		s3, err := s3store.NewS3Store(lg, config.S3Config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create s3 store: %w", err)
+		}
+		return s3, nil
 	default:
 		return nil, fmt.Errorf("failed to connect object: invalid component")
 	}
@@ -0,0 +1,98 @@
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+)
+
+// indexName は prefix 直下に置く索引のオブジェクト名
+const indexName = "index.json"
+
+// index は id (派生画像は "<id>@<variant>") と prefix を除いたキーの対応
+type index struct {
+	Entries map[string]string `json:"entries"`
+}
+
+// load は索引を読む
+// 索引が無い場合はバケットの一覧から作る
+func (s *S3Store) load() error {
+	s.entries = make(map[string]string)
+	s.keys = make(map[string]string)
+
+	obj, err := s.client.GetObject(context.Background(), s.bucket, s.prefix+indexName, minio.GetObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get index: %w", err)
+	}
+	defer obj.Close()
+	b, err := io.ReadAll(obj)
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return s.rebuild()
+	} else if err != nil {
+		return fmt.Errorf("failed to read index: %w", err)
+	}
+	var idx index
+	if err := json.Unmarshal(b, &idx); err != nil {
+		return fmt.Errorf("failed to decode index: %w", err)
+	}
+	for name, key := range idx.Entries {
+		s.entries[name] = key
+		s.keys[key] = name
+	}
+	return nil
+}
+
+// rebuild は prefix 以下のオブジェクトを一度だけ一覧して索引を作る
+// 拡張子だけが違うキーを id の画像、"<id>@<variant>" を派生画像とする
+func (s *S3Store) rebuild() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: s.prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return fmt.Errorf("failed to list objects: %w", obj.Err)
+		}
+		key := strings.TrimPrefix(obj.Key, s.prefix)
+		if key == indexName {
+			continue
+		}
+		id, variant, isVariant := strings.Cut(key, "@")
+		if !isVariant {
+			id = strings.TrimSuffix(id, path.Ext(id))
+		}
+		if storecommon.ValidateID(id) != nil {
+			continue
+		}
+		name := id
+		if isVariant {
+			if storecommon.ValidateVariant(variant) != nil {
+				continue
+			}
+			name = id + "@" + variant
+		}
+		s.entries[name] = key
+		s.keys[key] = name
+	}
+	return s.save()
+}
+
+// save は索引を書き出す (呼び出し側で mu を取る)
+func (s *S3Store) save() error {
+	b, err := json.MarshalIndent(index{Entries: s.entries}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode index: %w", err)
+	}
+	_, err = s.client.PutObject(context.Background(), s.bucket, s.prefix+indexName, bytes.NewReader(b), int64(len(b)), minio.PutObjectOptions{
+		ContentType: "application/json",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write index: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+	storeconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/config"
+)
+
+const defaultPresignExpiry = time.Hour
+
+// S3Store は画像を S3 互換のバケットに置く
+// 画像を探すたびにオブジェクトを一覧しないように、id とキーの対応を prefix/index.json の索引で管理する
+// 索引はこのプロセスだけが書き換える前提で、同じ prefix を複数のバックエンドで共有しない
+type S3Store struct {
+	lg     *slog.Logger
+	client *minio.Client
+
+	bucket        string
+	prefix        string
+	presign       bool
+	presignExpiry time.Duration
+
+	mu sync.Mutex
+	// entries は id ごとのキー、keys はキーから id を引く
+	entries map[string]string
+	keys    map[string]string
+}
+
+func NewS3Store(lg *slog.Logger, config storeconfig.S3Config) (*S3Store, error) {
+	return newS3Store(lg, config, nil)
+}
+
+// newS3Store は transport を使ってバケットに接続する (nil の場合は既定の transport)
+func newS3Store(lg *slog.Logger, config storeconfig.S3Config, transport http.RoundTripper) (*S3Store, error) {
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, fmt.Errorf("endpoint and bucket are required")
+	}
+	lookup := minio.BucketLookupAuto
+	if config.PathStyle {
+		lookup = minio.BucketLookupPath
+	}
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(config.AccessKeyID, config.SecretAccessKey, ""),
+		Secure:       !config.Insecure,
+		Region:       config.Region,
+		BucketLookup: lookup,
+		Transport:    transport,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	exists, err := client.BucketExists(ctx, config.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bucket: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("bucket does not exist: %s", config.Bucket)
+	}
+
+	expiry := config.PresignExpiry
+	if expiry <= 0 {
+		expiry = defaultPresignExpiry
+	}
+	s := &S3Store{
+		lg:            lg.With(slog.String("Package", "s3")),
+		client:        client,
+		bucket:        config.Bucket,
+		prefix:        config.Prefix,
+		presign:       config.Presign,
+		presignExpiry: expiry,
+	}
+	if err := s.load(); err != nil {
+		return nil, fmt.Errorf("failed to load index: %w", err)
+	}
+	return s, nil
+}
+
+func (s *S3Store) Close() error {
+	return nil
+}
+
+// Upload は r の画像を id の表紙として保存する
+func (s *S3Store) Upload(id string, r io.Reader, ct string) error {
+	ext := storecommon.ImageExt(ct)
+	if ext == "" {
+		return fmt.Errorf("unsupported content type: %q", ct)
+	}
+	if err := storecommon.ValidateID(id); err != nil {
+		return err
+	}
+	key := id + ext
+	if err := s.put(key, r, ct); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// 新しい画像を置けてから、拡張子の違う古い画像と古い画像から作った派生画像を消す
+	for name, old := range s.entries {
+		if name == id && old != key || strings.HasPrefix(name, id+"@") {
+			if err := s.remove(old); err != nil {
+				return err
+			}
+			delete(s.entries, name)
+			delete(s.keys, old)
+		}
+	}
+	s.entries[id] = key
+	s.keys[key] = id
+	return s.save()
+}
+
+// Get は画像のキーを prefix を除いて返す
+func (s *S3Store) Get(id string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.entries[id], nil
+}
+
+// PresignedURL は Presign の場合に署名付き URL を返す
+func (s *S3Store) PresignedURL(name string) (string, error) {
+	if !s.presign {
+		return "", nil
+	}
+	u, err := s.client.PresignedGetObject(context.Background(), s.bucket, s.prefix+name, s.presignExpiry, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to presign object: %w", err)
+	}
+	return u.String(), nil
+}
+
+// Open は Get が返したキーの画像を開く
+// 索引に無いキーは開かない
+func (s *S3Store) Open(name string) (*storecommon.Object, error) {
+	s.mu.Lock()
+	_, ok := s.keys[name]
+	s.mu.Unlock()
+	if !ok {
+		return nil, storecommon.ErrNotFoundImage
+	}
+	obj, err := s.client.GetObject(context.Background(), s.bucket, s.prefix+name, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	// GetObject はリクエストを遅延するので Stat で存在を確かめる
+	info, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, storecommon.ErrNotFoundImage
+		}
+		return nil, fmt.Errorf("failed to stat object: %w", err)
+	}
+	return &storecommon.Object{
+		ReadSeekCloser: obj,
+		ContentType:    info.ContentType,
+		ModTime:        info.LastModified,
+		ETag:           info.ETag,
+	}, nil
+}
+
+// PutVariant は派生画像を "<id>@<variant>" のキーで保存する
+func (s *S3Store) PutVariant(id, variant string, r io.Reader, contentType string) error {
+	if err := storecommon.ValidateID(id); err != nil {
+		return err
+	}
+	if err := storecommon.ValidateVariant(variant); err != nil {
+		return err
+	}
+	key := id + "@" + variant
+	if err := s.put(key, r, contentType); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = key
+	s.keys[key] = key
+	return s.save()
+}
+
+// Delete は id の画像と派生画像を消す
+// "<id>_<写真の ID>" のように id で始まる別の画像は消さない
+func (s *S3Store) Delete(id string) error {
+	// 空の id などで prefix 以下を全て消さないようにする
+	if err := storecommon.ValidateID(id); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var deleted bool
+	for name, key := range s.entries {
+		if name != id && !strings.HasPrefix(name, id+"@") {
+			continue
+		}
+		if err := s.remove(key); err != nil {
+			return err
+		}
+		delete(s.entries, name)
+		delete(s.keys, key)
+		deleted = true
+	}
+	if !deleted {
+		return nil
+	}
+	return s.save()
+}
+
+// List は索引にある元の画像の id を返す
+func (s *S3Store) List() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	for name := range s.entries {
+		if !strings.Contains(name, "@") {
+			ids = append(ids, name)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// put は r を読み切ってから大きさを指定して key に置く
+// 大きさが分からないと minio-go はマルチパートアップロード用に大きなバッファを確保する
+func (s *S3Store) put(key string, r io.Reader, contentType string) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("failed to read image: %w", err)
+	}
+	_, err = s.client.PutObject(context.Background(), s.bucket, s.prefix+key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put object: %w", err)
+	}
+	return nil
+}
+
+func (s *S3Store) remove(key string) error {
+	if err := s.client.RemoveObject(context.Background(), s.bucket, s.prefix+key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("failed to delete object %s: %w", key, err)
+	}
+	return nil
+}
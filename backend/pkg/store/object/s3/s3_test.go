@@ -0,0 +1,359 @@
+package s3store
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
+	storeconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/config"
+)
+
+const testBucket = "covers"
+
+type fakeObject struct {
+	data        []byte
+	contentType string
+	modTime     time.Time
+}
+
+// fakeS3 は S3Store が使う API だけを持つパス形式の S3 サーバー
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string]fakeObject
+	// lists は ListObjects が呼ばれた回数
+	lists int
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != testBucket {
+		writeError(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if key == "" {
+		switch {
+		case r.Method == http.MethodHead:
+		case r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2":
+			f.lists++
+			f.list(w, r.URL.Query())
+		default:
+			writeError(w, http.StatusNotImplemented, "NotImplemented")
+		}
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		f.objects[key] = fakeObject{data: data, contentType: r.Header.Get("Content-Type"), modTime: time.Now()}
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodGet, http.MethodHead:
+		obj, ok := f.objects[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("Content-Type", obj.contentType)
+		w.Header().Set("ETag", `"etag"`)
+		http.ServeContent(w, r, key, obj.modTime, bytes.NewReader(obj.data))
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, query url.Values) {
+	type contents struct {
+		Key  string
+		Size int64
+	}
+	type commonPrefix struct {
+		Prefix string
+	}
+	result := struct {
+		XMLName        xml.Name `xml:"ListBucketResult"`
+		Name           string
+		Prefix         string
+		KeyCount       int
+		MaxKeys        int
+		IsTruncated    bool
+		Contents       []contents
+		CommonPrefixes []commonPrefix
+	}{Name: testBucket, Prefix: query.Get("prefix"), MaxKeys: 1000}
+
+	delimiter := query.Get("delimiter")
+	seen := make(map[string]bool)
+	var keys []string
+	for key := range f.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		rest, ok := strings.CutPrefix(key, result.Prefix)
+		if !ok {
+			continue
+		}
+		if i := strings.Index(rest, delimiter); delimiter != "" && i >= 0 {
+			if p := result.Prefix + rest[:i+len(delimiter)]; !seen[p] {
+				seen[p] = true
+				result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: p})
+			}
+			continue
+		}
+		result.Contents = append(result.Contents, contents{Key: key, Size: int64(len(f.objects[key].data))})
+	}
+	result.KeyCount = len(result.Contents) + len(result.CommonPrefixes)
+	w.Header().Set("Content-Type", "application/xml")
+	xml.NewEncoder(w).Encode(result)
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+	}{Code: code})
+}
+
+func (f *fakeS3) keys() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var keys []string
+	for key := range f.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (f *fakeS3) listCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.lists
+}
+
+// newTestStore は fake に接続した S3Store を作る
+func newTestStore(t *testing.T, fake *fakeS3, prefix string) *S3Store {
+	t.Helper()
+	server := httptest.NewTLSServer(fake)
+	t.Cleanup(server.Close)
+
+	s, err := newS3Store(slog.New(slog.NewTextHandler(io.Discard, nil)), storeconfig.S3Config{
+		Endpoint:        strings.TrimPrefix(server.URL, "https://"),
+		Region:          "us-east-1",
+		Bucket:          testBucket,
+		Prefix:          prefix,
+		AccessKeyID:     "test",
+		SecretAccessKey: "test",
+		PathStyle:       true,
+	}, server.Client().Transport)
+	if err != nil {
+		t.Fatalf("newS3Store() error = %v", err)
+	}
+	return s
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: make(map[string]fakeObject)}
+}
+
+func TestUploadReplacesExtension(t *testing.T) {
+	fake := newFakeS3()
+	s := newTestStore(t, fake, "covers/")
+
+	if err := s.Upload("9784101001565", strings.NewReader("png"), "image/png"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if err := s.PutVariant("9784101001565", "256.webp", strings.NewReader("webp"), "image/webp"); err != nil {
+		t.Fatalf("PutVariant() error = %v", err)
+	}
+	if err := s.Upload("9784101001565", strings.NewReader("jpeg"), "image/jpeg"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	// 古い拡張子の画像と古い画像から作った派生画像は消える
+	want := []string{"covers/9784101001565.jpeg", "covers/index.json"}
+	if got := fake.keys(); !reflect.DeepEqual(got, want) {
+		t.Errorf("objects = %v, want %v", got, want)
+	}
+	key, err := s.Get("9784101001565")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if key != "9784101001565.jpeg" {
+		t.Errorf("Get() = %q, want %q", key, "9784101001565.jpeg")
+	}
+
+	obj, err := s.Open(key)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer obj.Close()
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if string(data) != "jpeg" || obj.ContentType != "image/jpeg" {
+		t.Errorf("Open() = %q (%s), want %q (%s)", data, obj.ContentType, "jpeg", "image/jpeg")
+	}
+}
+
+func TestDeleteKeepsPrefixSharingIDs(t *testing.T) {
+	fake := newFakeS3()
+	s := newTestStore(t, fake, "")
+
+	for _, id := range []string{"978410100156", "9784101001565", "97841010015650", "9784101001565_2f1c7a4e"} {
+		if err := s.Upload(id, strings.NewReader(id), "image/jpeg"); err != nil {
+			t.Fatalf("Upload(%q) error = %v", id, err)
+		}
+		if err := s.PutVariant(id, "256.webp", strings.NewReader(id), "image/webp"); err != nil {
+			t.Fatalf("PutVariant(%q) error = %v", id, err)
+		}
+	}
+
+	if err := s.Delete("9784101001565"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	want := []string{
+		"978410100156.jpeg",
+		"97841010015650.jpeg",
+		"97841010015650@256.webp",
+		"9784101001565_2f1c7a4e.jpeg",
+		"9784101001565_2f1c7a4e@256.webp",
+		"978410100156@256.webp",
+		"index.json",
+	}
+	if got := fake.keys(); !reflect.DeepEqual(got, want) {
+		t.Errorf("objects = %v, want %v", got, want)
+	}
+	if key, _ := s.Get("9784101001565"); key != "" {
+		t.Errorf("Get() = %q, want empty", key)
+	}
+	if err := s.Delete("../"); err == nil {
+		t.Error("Delete(\"../\") error = nil, want error")
+	}
+}
+
+func TestList(t *testing.T) {
+	fake := newFakeS3()
+	s := newTestStore(t, fake, "covers/")
+
+	for _, id := range []string{"9784101001565", "2f1c7a4e-9b7d-4c55-8a53-5bb0f8e3c1d2"} {
+		if err := s.Upload(id, strings.NewReader(id), "image/png"); err != nil {
+			t.Fatalf("Upload(%q) error = %v", id, err)
+		}
+		if err := s.PutVariant(id, "128.jpeg", strings.NewReader(id), "image/jpeg"); err != nil {
+			t.Fatalf("PutVariant(%q) error = %v", id, err)
+		}
+	}
+
+	ids, err := s.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if want := []string{"2f1c7a4e-9b7d-4c55-8a53-5bb0f8e3c1d2", "9784101001565"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("List() = %v, want %v", ids, want)
+	}
+}
+
+func TestOpenNotFound(t *testing.T) {
+	fake := newFakeS3()
+	s := newTestStore(t, fake, "covers/")
+
+	if err := s.Upload("9784101001565", strings.NewReader("png"), "image/png"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	// 索引にあってもバケットから消えていれば見つからない
+	fake.mu.Lock()
+	delete(fake.objects, "covers/9784101001565.png")
+	fake.mu.Unlock()
+
+	for _, name := range []string{"9784101001565.png", "9784101001565.jpeg", "index.json", "../secret.png", ""} {
+		if _, err := s.Open(name); !errors.Is(err, storecommon.ErrNotFoundImage) {
+			t.Errorf("Open(%q) error = %v, want %v", name, err, storecommon.ErrNotFoundImage)
+		}
+	}
+}
+
+// Get は一覧を取らずに索引から引く
+func TestGetUsesIndex(t *testing.T) {
+	fake := newFakeS3()
+	s := newTestStore(t, fake, "covers/")
+	if err := s.Upload("9784101001565", strings.NewReader("png"), "image/png"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	lists := fake.listCount()
+	for range 10 {
+		if _, err := s.Get("9784101001565"); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+	}
+	if got := fake.listCount(); got != lists {
+		t.Errorf("ListObjects called %d times by Get, want 0", got-lists)
+	}
+
+	// 別のプロセスからは保存された索引を読む
+	reopened := newTestStore(t, fake, "covers/")
+	if key, _ := reopened.Get("9784101001565"); key != "9784101001565.png" {
+		t.Errorf("Get() = %q, want %q", key, "9784101001565.png")
+	}
+	if got := fake.listCount(); got != lists {
+		t.Errorf("ListObjects called %d times on reopen, want 0", got-lists)
+	}
+}
+
+// 索引が無いバケットは一度だけ一覧して索引を作る
+func TestRebuildIndex(t *testing.T) {
+	fake := newFakeS3()
+	for _, key := range []string{
+		"covers/9784101001565.jpeg",
+		"covers/9784101001565@256.webp",
+		"covers/9784101001565_2f1c7a4e.png",
+		"covers/notes.txt.bak/x",
+		"other/9784000000000.jpeg",
+	} {
+		fake.objects[key] = fakeObject{data: []byte(key), contentType: "image/jpeg", modTime: time.Now()}
+	}
+	s := newTestStore(t, fake, "covers/")
+
+	if got := fake.listCount(); got != 1 {
+		t.Errorf("ListObjects called %d times, want 1", got)
+	}
+	ids, err := s.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if want := []string{"9784101001565", "9784101001565_2f1c7a4e"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("List() = %v, want %v", ids, want)
+	}
+	if key, _ := s.Get("9784101001565"); key != "9784101001565.jpeg" {
+		t.Errorf("Get() = %q, want %q", key, "9784101001565.jpeg")
+	}
+	if _, ok := fake.objects["covers/index.json"]; !ok {
+		t.Error("index.json is not written")
+	}
+}
@@ -1,21 +1,27 @@
 package store
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 
 	bookscommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/books/common"
 	storecommon "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/common"
 	storeconfig "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/config"
 	"github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/db"
+	storefetch "github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/fetch"
 	"github.com/nyahahanoha/BookManagementSystem/backend/pkg/store/object"
 )
 
 type BookStore struct {
 	lg *slog.Logger
 
-	db     db.DBStore
-	object object.ObjectStore
+	db      db.DBStore
+	object  object.ObjectStore
+	fetcher *storefetch.Fetcher
 }
 
 func NewBooksStore(lg *slog.Logger, config storeconfig.Config) (*BookStore, error) {
@@ -32,11 +38,17 @@ func NewBooksStore(lg *slog.Logger, config storeconfig.Config) (*BookStore, erro
 		return nil, fmt.Errorf("failed to connect object: %w", err)
 	}
 
+	return New(lg, db, object, storefetch.NewFetcher(lg, config.Object.Fetch)), nil
+}
+
+// New は作成済みの DBStore と ObjectStore から BookStore を作る
+func New(lg *slog.Logger, db db.DBStore, object object.ObjectStore, fetcher *storefetch.Fetcher) *BookStore {
 	return &BookStore{
-		lg:     lg,
-		db:     db,
-		object: object,
-	}, nil
+		lg:      lg,
+		db:      db,
+		object:  object,
+		fetcher: fetcher,
+	}
 }
 
 func (s *BookStore) Close() error {
@@ -49,73 +61,252 @@ func (s *BookStore) Close() error {
 	return nil
 }
 
+// Put は本の情報と表紙を保存する
+// 表紙を先に ObjectStore に置き、DB への書き込みに失敗した場合は以前の表紙に戻す
+// 縮小画像と仮の表紙は後から作り直せるので、失敗しても Put は失敗にしない
 func (s *BookStore) Put(book bookscommon.Info) error {
+	current, err := s.db.Get(book.ID)
+	if err != nil && !errors.Is(err, storecommon.ErrNotFoundBook) {
+		return fmt.Errorf("failed to get info in db: %w", err)
+	}
+
+	// 利用者がアップロードした表紙や選んだ表紙はプロバイダーの表紙で置き換えない
+	var image *storefetch.Image
+	if !current.Image.Origin.Locked() && book.Image.Source.String() != "" {
+		image, err = s.fetcher.Fetch(context.Background(), book.Image.Source)
+		if err != nil {
+			// 表紙が取れなくても本は登録し、以前の表紙か仮の表紙を使う
+			s.lg.Warn("failed to fetch image", slog.String("id", book.ID), slog.String("err", err.Error()))
+		}
+	}
+
+	if image == nil {
+		if err := s.db.Put(book); err != nil {
+			return fmt.Errorf("failed to put info in db: %w", err)
+		}
+		if !current.Image.Origin.Locked() {
+			if err := s.ensurePlaceholder(book.ID); err != nil {
+				s.lg.Warn("failed to put placeholder", slog.String("id", book.ID), slog.String("err", err.Error()))
+			}
+		}
+		return nil
+	}
+
+	backup, err := s.backupImage(book.ID)
+	if err != nil {
+		return err
+	}
+	if err := s.object.Upload(book.ID, bytes.NewReader(image.Data), image.ContentType); err != nil {
+		return fmt.Errorf("failed to put image in object: %w", err)
+	}
 	if err := s.db.Put(book); err != nil {
+		s.restoreImage(book.ID, backup)
 		return fmt.Errorf("failed to put info in db: %w", err)
 	}
-	if err := s.object.Put(book.Image.Source, book.ISBN); err != nil {
-		return fmt.Errorf("failed to put image in object: %w", err)
+	if err := s.processImage(book.ID, bookscommon.Image{Origin: bookscommon.ImageProvider}); err != nil {
+		// 縮小画像が無くても元の画像は返せるので失敗にはしない
+		s.lg.Warn("failed to process image", slog.String("id", book.ID), slog.String("err", err.Error()))
+		if err := s.db.PutImageMeta(book.ID, bookscommon.Image{Origin: bookscommon.ImageProvider}); err != nil {
+			s.lg.Warn("failed to put image meta in db", slog.String("id", book.ID), slog.String("err", err.Error()))
+		}
+	}
+	return nil
+}
+
+// UploadImage は利用者がアップロードした画像を id の本の表紙にする
+// 取得元を記録できなかった場合は以前の表紙に戻す
+func (s *BookStore) UploadImage(id string, r io.Reader, contentType string) error {
+	backup, err := s.backupImage(id)
+	if err != nil {
+		return err
+	}
+	if err := s.object.Upload(id, r, contentType); err != nil {
+		return fmt.Errorf("failed to upload image in object: %w", err)
+	}
+	if err := s.processImage(id, bookscommon.Image{Origin: bookscommon.ImageUser}); err != nil {
+		s.lg.Warn("failed to process image", slog.String("id", id), slog.String("err", err.Error()))
+		// 縮小画像が作れなくてもプロバイダーの表紙で上書きされないようにしておく
+		if err := s.db.PutImageMeta(id, bookscommon.Image{Origin: bookscommon.ImageUser}); err != nil {
+			s.restoreImage(id, backup)
+			return fmt.Errorf("failed to put image meta in db: %w", err)
+		}
 	}
 	return nil
 }
-func (s *BookStore) Get(isbn string) (bookscommon.Info, error) {
-	info, err := s.db.Get(isbn)
+
+func (s *BookStore) Get(id string) (bookscommon.Info, error) {
+	info, err := s.db.Get(id)
 	if err == storecommon.ErrNotFoundBook {
 		return bookscommon.Info{}, err
 	} else if err != nil {
 		return bookscommon.Info{}, fmt.Errorf("failed to get info in db: %w", err)
 	}
-	path, err := s.object.Get(isbn)
+	path, err := s.ImagePath(id)
 	if err != nil {
-		return bookscommon.Info{}, fmt.Errorf("failed to get image in object: %w", err)
+		return bookscommon.Info{}, fmt.Errorf("failed to get image path: %w", err)
 	}
 	info.Image.Path = path
 	return info, nil
 }
 
+// OpenImage は Get で返した Image.Path の画像を開く
+func (s *BookStore) OpenImage(name string) (*storecommon.Object, error) {
+	r, err := s.object.Open(name)
+	if err == storecommon.ErrNotFoundImage {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open image in object: %w", err)
+	}
+	return r, nil
+}
+
+// ImagePath は id の本の Image.Path を返す (画像が無い場合は空)
+// 署名付き URL を使う ObjectStore の場合は URL を返す
+func (s *BookStore) ImagePath(id string) (string, error) {
+	key, err := s.object.Get(id)
+	if err != nil {
+		return "", fmt.Errorf("failed to get image in object: %w", err)
+	}
+	if presigner, ok := s.object.(object.Presigner); ok && key != "" {
+		u, err := presigner.PresignedURL(key)
+		if err != nil {
+			return "", fmt.Errorf("failed to presign image: %w", err)
+		}
+		if u != "" {
+			return u, nil
+		}
+	}
+	return key, nil
+}
+
+func (s *BookStore) Resolve(id bookscommon.Identifier) (string, error) {
+	bookID, err := s.db.Resolve(id)
+	if err == storecommon.ErrNotFoundBook {
+		return "", err
+	} else if err != nil {
+		return "", fmt.Errorf("failed to resolve identifier in db: %w", err)
+	}
+	return bookID, nil
+}
+
 func (s *BookStore) GetAll() ([]bookscommon.Info, error) {
 	books, err := s.db.GetAll()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get info in db: %w", err)
 	}
 	for i, book := range books {
-		path, err := s.object.Get(book.ISBN)
+		path, err := s.ImagePath(book.ID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get image in object: %w", err)
+			return nil, fmt.Errorf("failed to get image path: %w", err)
 		}
 		books[i].Image.Path = path
 	}
 	return books, nil
 }
 
-func (s *BookStore) Search(title string) ([]bookscommon.Info, error) {
-	books, err := s.db.Search(title)
+func (s *BookStore) Search(filter bookscommon.Filter) ([]bookscommon.Info, error) {
+	books, err := s.db.Search(filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get info in db: %w", err)
 	}
 	for i, book := range books {
-		path, err := s.object.Get(book.ISBN)
+		path, err := s.ImagePath(book.ID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get image in object: %w", err)
+			return nil, fmt.Errorf("failed to get image path: %w", err)
 		}
 		books[i].Image.Path = path
 	}
 	return books, nil
 }
 
-func (s *BookStore) Del(isbn string) error {
-	if err := s.db.Delete(isbn); err != nil {
+// Del は本を削除してから表紙を消す
+// 表紙を消せなかった場合は孤立した画像が残るだけなので失敗にはせず、fsck で消す
+func (s *BookStore) Del(id string) error {
+	// 写真の行は本と一緒に消えるので先に読んでおく
+	attachments, err := s.db.GetAttachments(id)
+	if err != nil {
+		return fmt.Errorf("failed to get attachments in db: %w", err)
+	}
+	if err := s.db.Delete(id); err != nil {
 		return fmt.Errorf("failed to delete info in db: %w", err)
 	}
-	if err := s.object.Delete(isbn); err != nil {
-		return fmt.Errorf("failed to delete image in object: %w", err)
+	if err := s.object.Delete(id); err != nil {
+		s.lg.Warn("failed to delete image in object", slog.String("id", id), slog.String("err", err.Error()))
+	}
+	for _, attachment := range attachments {
+		s.deleteAttachmentImage(id, attachment.ID)
 	}
 	return nil
 }
 
-func (s *BookStore) Rename(isbn, title string) error {
-	if err := s.db.Rename(isbn, title); err != nil {
+func (s *BookStore) Rename(id, title string) error {
+	if err := s.db.Rename(id, title); err != nil {
 		return fmt.Errorf("failed to rename info in db: %w", err)
 	}
+	// 仮の表紙には書名が書いてあるので作り直す
+	info, err := s.db.Get(id)
+	if err != nil {
+		return fmt.Errorf("failed to get info in db: %w", err)
+	}
+	if info.Image.Origin == bookscommon.ImageGenerated {
+		if err := s.putPlaceholder(info); err != nil {
+			s.lg.Warn("failed to put placeholder", slog.String("id", id), slog.String("err", err.Error()))
+		}
+	}
 	return nil
 }
+
+func (s *BookStore) GetCache(source, isbn string) (bookscommon.CacheEntry, error) {
+	entry, err := s.db.GetCache(source, isbn)
+	if err == storecommon.ErrNotFoundCache {
+		return bookscommon.CacheEntry{}, err
+	} else if err != nil {
+		return bookscommon.CacheEntry{}, fmt.Errorf("failed to get cache in db: %w", err)
+	}
+	return entry, nil
+}
+
+func (s *BookStore) PutCache(entry bookscommon.CacheEntry) error {
+	if err := s.db.PutCache(entry); err != nil {
+		return fmt.Errorf("failed to put cache in db: %w", err)
+	}
+	return nil
+}
+
+func (s *BookStore) ListCache(isbn string) ([]bookscommon.CacheEntry, error) {
+	entries, err := s.db.ListCache(isbn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cache in db: %w", err)
+	}
+	return entries, nil
+}
+
+func (s *BookStore) DeleteCache(source, isbn string) error {
+	if err := s.db.DeleteCache(source, isbn); err != nil {
+		return fmt.Errorf("failed to delete cache in db: %w", err)
+	}
+	return nil
+}
+
+func (s *BookStore) CountClassification(prefix string) (map[string]int, error) {
+	counts, err := s.db.CountClassification(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count classification in db: %w", err)
+	}
+	return counts, nil
+}
+
+func (s *BookStore) GetBooksByClassification(prefix string) ([]bookscommon.Info, error) {
+	books, err := s.db.GetBooksByClassification(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get info in db: %w", err)
+	}
+	for i, book := range books {
+		path, err := s.ImagePath(book.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get image path: %w", err)
+		}
+		books[i].Image.Path = path
+	}
+	return books, nil
+}
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file book_management_system/v1/book.proto.
  */
 export const file_book_management_system_v1_book: GenFile = /*@__PURE__*/
-  fileDesc("CiRib29rX21hbmFnZW1lbnRfc3lzdGVtL3YxL2Jvb2sucHJvdG8SGWJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEiHgoOUHV0Qm9va1JlcXVlc3QSDAoEaXNibhgBIAEoCSJACg9QdXRCb29rUmVzcG9uc2USLQoEYm9vaxgBIAEoCzIfLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuQm9vayIeCg5HZXRCb29rUmVxdWVzdBIMCgRpc2JuGAEgASgJIkAKD0dldEJvb2tSZXNwb25zZRItCgRib29rGAEgASgLMh8uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5Cb29rIhQKEkdldEFsbEJvb2tzUmVxdWVzdCJFChNHZXRBbGxCb29rc1Jlc3BvbnNlEi4KBWJvb2tzGAEgAygLMh8uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5Cb29rIiIKEVNlYXJjaEJvb2tSZXF1ZXN0Eg0KBXRpdGxlGAEgASgJIkQKElNlYXJjaEJvb2tSZXNwb25zZRIuCgVib29rcxgBIAMoCzIfLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuQm9vayKnAQoEQm9vaxIMCgRpc2JuGAEgASgJEg0KBXRpdGxlGAIgASgJEg8KB2F1dGhvcnMYAyADKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSEwoLcHVibGlzaGRhdGUYBSABKAkSNQoIbGFuZ3VhZ2UYBiABKA4yIy5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkxhbmd1YWdlEhAKCGltYWdldXJsGAcgASgJIjAKEVJlbmFtZUJvb2tSZXF1ZXN0EgwKBGlzYm4YASABKAkSDQoFdGl0bGUYAiABKAkiFAoSUmVuYW1lQm9va1Jlc3BvbnNlIiEKEURlbGV0ZUJvb2tSZXF1ZXN0EgwKBGlzYm4YASABKAkiFAoSRGVsZXRlQm9va1Jlc3BvbnNlKjIKCExhbmd1YWdlEgsKB1VOS05PV04QABILCgdFTkdMSVNIEAESDAoISkFQQU5FU0UQAjKKBQoVQm9va01hbmFnZW1lbnRTZXJ2aWNlEmAKB1B1dEJvb2sSKS5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLlB1dEJvb2tSZXF1ZXN0GiouYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5QdXRCb29rUmVzcG9uc2USYAoHR2V0Qm9vaxIpLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuR2V0Qm9va1JlcXVlc3QaKi5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkdldEJvb2tSZXNwb25zZRJsCgtHZXRBbGxCb29rcxItLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuR2V0QWxsQm9va3NSZXF1ZXN0Gi4uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5HZXRBbGxCb29rc1Jlc3BvbnNlEmkKClNlYXJjaEJvb2sSLC5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLlNlYXJjaEJvb2tSZXF1ZXN0Gi0uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5TZWFyY2hCb29rUmVzcG9uc2USaQoKUmVuYW1lQm9vaxIsLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuUmVuYW1lQm9va1JlcXVlc3QaLS5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLlJlbmFtZUJvb2tSZXNwb25zZRJpCgpEZWxldGVCb29rEiwuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5EZWxldGVCb29rUmVxdWVzdBotLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuRGVsZXRlQm9va1Jlc3BvbnNlQpMCCh1jb20uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MUIJQm9va1Byb3RvUAFaamdpdGh1Yi5jb20vbnlhaGFoYW5vaGEvQm9va01hbmFnZW1lbnRTeXN0ZW0vYmFja2VuZC9hcGkvYm9va19tYW5hZ2VtZW50X3N5c3RlbS92MTtib29rX21hbmFnZW1lbnRfc3lzdGVtdjGiAgNCWFiqAhdCb29rTWFuYWdlbWVudFN5c3RlbS5WMcoCF0Jvb2tNYW5hZ2VtZW50U3lzdGVtXFYx4gIjQm9va01hbmFnZW1lbnRTeXN0ZW1cVjFcR1BCTWV0YWRhdGHqAhhCb29rTWFuYWdlbWVudFN5c3RlbTo6VjFiBnByb3RvMw");
+  fileDesc("CiRib29rX21hbmFnZW1lbnRfc3lzdGVtL3YxL2Jvb2sucHJvdG8SGWJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEiWQoOUHV0Qm9va1JlcXVlc3QSDAoEaXNibhgBIAEoCRI5CgppZGVudGlmaWVyGAIgASgLMiUuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5JZGVudGlmaWVyIkAKD1B1dEJvb2tSZXNwb25zZRItCgRib29rGAEgASgLMh8uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5Cb29rIkIKEUNyZWF0ZUJvb2tSZXF1ZXN0Ei0KBGJvb2sYASABKAsyHy5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkJvb2siQwoSQ3JlYXRlQm9va1Jlc3BvbnNlEi0KBGJvb2sYASABKAsyHy5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkJvb2siKgoOR2V0Qm9va1JlcXVlc3QSDAoEaXNibhgBIAEoCRIKCgJpZBgCIAEoCSJACg9HZXRCb29rUmVzcG9uc2USLQoEYm9vaxgBIAEoCzIfLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuQm9vayIUChJHZXRBbGxCb29rc1JlcXVlc3QiRQoTR2V0QWxsQm9va3NSZXNwb25zZRIuCgVib29rcxgBIAMoCzIfLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuQm9vayJGChFTZWFyY2hCb29rUmVxdWVzdBINCgV0aXRsZRgBIAEoCRIRCglwdWJsaXNoZXIYAiABKAkSDwoHc3ViamVjdBgDIAEoCSJEChJTZWFyY2hCb29rUmVzcG9uc2USLgoFYm9va3MYASADKAsyHy5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkJvb2siogUKBEJvb2sSDAoEaXNibhgBIAEoCRINCgV0aXRsZRgCIAEoCRIPCgdhdXRob3JzGAMgAygJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEhMKC3B1Ymxpc2hkYXRlGAUgASgJEjkKCGxhbmd1YWdlGAYgASgOMiMuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5MYW5ndWFnZUICGAESEAoIaW1hZ2V1cmwYByABKAkSCgoCaWQYCCABKAkSOgoLaWRlbnRpZmllcnMYCSADKAsyJS5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLklkZW50aWZpZXISEQoJcHVibGlzaGVyGAogASgJEg0KBXBhZ2VzGAsgASgFEhAKCHN1YmplY3RzGAwgAygJEg8KB2VkaXRpb24YDSABKAkSLwoFcHJpY2UYDiABKAsyIC5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLlByaWNlEjwKDGNvbnRyaWJ1dG9ycxgPIAMoCzImLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuQ29udHJpYnV0b3ISCwoDbmRjGBAgASgJEg4KBnNlcmllcxgRIAEoCRJHChVwdWJsaXNoZGF0ZV9wcmVjaXNpb24YEiABKA4yKC5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkRhdGVQcmVjaXNpb24SEQoJbGFuZ3VhZ2VzGBMgAygJEhMKC2ltYWdlX2NvbG9yGBQgASgJEhYKDmltYWdlX2JsdXJoYXNoGBUgASgJEjwKDGltYWdlX29yaWdpbhgWIAEoDjImLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuSW1hZ2VPcmlnaW4SFQoNdGl0bGVfcmVhZGluZxgXIAEoCSJ5CgtDb250cmlidXRvchIMCgRuYW1lGAEgASgJEjgKBHJvbGUYAiABKA4yKi5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkNvbnRyaWJ1dG9yUm9sZRIRCglhdXRob3JfaWQYAyABKAMSDwoHcmVhZGluZxgEIAEoCSIpCgVQcmljZRIOCgZhbW91bnQYASABKAESEAoIY3VycmVuY3kYAiABKAkiVAoKSWRlbnRpZmllchI3CgR0eXBlGAEgASgOMikuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5JZGVudGlmaWVyVHlwZRINCgV2YWx1ZRgCIAEoCSI8ChFSZW5hbWVCb29rUmVxdWVzdBIMCgRpc2JuGAEgASgJEg0KBXRpdGxlGAIgASgJEgoKAmlkGAMgASgJIhQKElJlbmFtZUJvb2tSZXNwb25zZSItChFEZWxldGVCb29rUmVxdWVzdBIMCgRpc2JuGAEgASgJEgoKAmlkGAIgASgJIhQKEkRlbGV0ZUJvb2tSZXNwb25zZSI9ChJVcGxvYWRDb3ZlclJlcXVlc3QSCgoCaWQYASABKAkSDAoEaXNibhgCIAEoCRINCgVjaHVuaxgDIAEoDCJEChNVcGxvYWRDb3ZlclJlc3BvbnNlEi0KBGJvb2sYASABKAsyHy5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkJvb2sipQEKCkF0dGFjaG1lbnQSCgoCaWQYASABKAkSNwoEa2luZBgCIAEoDjIpLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuQXR0YWNobWVudEtpbmQSDwoHY2FwdGlvbhgDIAEoCRIQCghpbWFnZXVybBgEIAEoCRINCgV3aWR0aBgFIAEoBRIOCgZoZWlnaHQYBiABKAUSEAoIcG9zaXRpb24YByABKAUijAEKF1VwbG9hZEF0dGFjaG1lbnRSZXF1ZXN0EgoKAmlkGAEgASgJEgwKBGlzYm4YAiABKAkSNwoEa2luZBgDIAEoDjIpLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuQXR0YWNobWVudEtpbmQSDwoHY2FwdGlvbhgEIAEoCRINCgVjaHVuaxgFIAEoDCJVChhVcGxvYWRBdHRhY2htZW50UmVzcG9uc2USOQoKYXR0YWNobWVudBgBIAEoCzIlLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuQXR0YWNobWVudCIyChZMaXN0QXR0YWNobWVudHNSZXF1ZXN0EgoKAmlkGAEgASgJEgwKBGlzYm4YAiABKAkiVQoXTGlzdEF0dGFjaG1lbnRzUmVzcG9uc2USOgoLYXR0YWNobWVudHMYASADKAsyJS5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkF0dGFjaG1lbnQiSgoXRGVsZXRlQXR0YWNobWVudFJlcXVlc3QSCgoCaWQYASABKAkSDAoEaXNibhgCIAEoCRIVCg1hdHRhY2htZW50X2lkGAMgASgJIhoKGERlbGV0ZUF0dGFjaG1lbnRSZXNwb25zZSJNChlSZW9yZGVyQXR0YWNobWVudHNSZXF1ZXN0EgoKAmlkGAEgASgJEgwKBGlzYm4YAiABKAkSFgoOYXR0YWNobWVudF9pZHMYAyADKAkiWAoaUmVvcmRlckF0dGFjaG1lbnRzUmVzcG9uc2USOgoLYXR0YWNobWVudHMYASADKAsyJS5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkF0dGFjaG1lbnQiNgoaTGlzdENvdmVyQ2FuZGlkYXRlc1JlcXVlc3QSCgoCaWQYASABKAkSDAoEaXNibhgCIAEoCSJcChtMaXN0Q292ZXJDYW5kaWRhdGVzUmVzcG9uc2USPQoKY2FuZGlkYXRlcxgBIAMoCzIpLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuQ292ZXJDYW5kaWRhdGUibQoOQ292ZXJDYW5kaWRhdGUSDgoGc291cmNlGAEgASgJEg0KBWxhYmVsGAIgASgJEgsKA3VybBgDIAEoCRINCgV3aWR0aBgEIAEoBRIOCgZoZWlnaHQYBSABKAUSEAoIc2VsZWN0ZWQYBiABKAgiOwoSU2VsZWN0Q292ZXJSZXF1ZXN0EgoKAmlkGAEgASgJEgwKBGlzYm4YAiABKAkSCwoDdXJsGAMgASgJIkQKE1NlbGVjdENvdmVyUmVzcG9uc2USLQoEYm9vaxgBIAEoCzIfLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuQm9vayI1ChRTZWFyY2hDYXRhbG9nUmVxdWVzdBINCgV0aXRsZRgBIAEoCRIOCgZhdXRob3IYAiABKAkiWAoVU2VhcmNoQ2F0YWxvZ1Jlc3BvbnNlEj8KCmNhbmRpZGF0ZXMYASADKAsyKy5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkNhdGFsb2dDYW5kaWRhdGUiUgoQQ2F0YWxvZ0NhbmRpZGF0ZRItCgRib29rGAEgASgLMh8uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5Cb29rEg8KB3NvdXJjZXMYAiADKAkigwEKElByb3ZpZGVyQ2FjaGVFbnRyeRIOCgZzb3VyY2UYASABKAkSDAoEaXNibhgCIAEoCRIRCglub3RfZm91bmQYAyABKAgSFAoMY3JlYXRlZF90aW1lGAQgASgJEhQKDGV4cGlyZXNfdGltZRgFIAEoCRIQCghyZXNwb25zZRgGIAEoCSIoChhMaXN0UHJvdmlkZXJDYWNoZVJlcXVlc3QSDAoEaXNibhgBIAEoCSJbChlMaXN0UHJvdmlkZXJDYWNoZVJlc3BvbnNlEj4KB2VudHJpZXMYASADKAsyLS5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLlByb3ZpZGVyQ2FjaGVFbnRyeSI+Ch5JbnZhbGlkYXRlUHJvdmlkZXJDYWNoZVJlcXVlc3QSDAoEaXNibhgBIAEoCRIOCgZzb3VyY2UYAiABKAkiIQofSW52YWxpZGF0ZVByb3ZpZGVyQ2FjaGVSZXNwb25zZSJECgZBdXRob3ISCgoCaWQYASABKAMSDAoEbmFtZRgCIAEoCRIPCgdyZWFkaW5nGAMgASgJEg8KB2FsaWFzZXMYBCADKAkiIwoSTGlzdEF1dGhvcnNSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJIkkKE0xpc3RBdXRob3JzUmVzcG9uc2USMgoHYXV0aG9ycxgBIAMoCzIhLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuQXV0aG9yIjsKGExpc3RCb29rc0J5QXV0aG9yUmVxdWVzdBIRCglhdXRob3JfaWQYASABKAMSDAoEbmFtZRgCIAEoCSJ+ChlMaXN0Qm9va3NCeUF1dGhvclJlc3BvbnNlEjEKBmF1dGhvchgBIAEoCzIhLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuQXV0aG9yEi4KBWJvb2tzGAIgAygLMh8uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5Cb29rIkgKE1VwZGF0ZUF1dGhvclJlcXVlc3QSMQoGYXV0aG9yGAEgASgLMiEuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5BdXRob3IiSQoUVXBkYXRlQXV0aG9yUmVzcG9uc2USMQoGYXV0aG9yGAEgASgLMiEuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5BdXRob3IiPAoTTWVyZ2VBdXRob3JzUmVxdWVzdBIRCgl0YXJnZXRfaWQYASABKAMSEgoKc291cmNlX2lkcxgCIAMoAyJJChRNZXJnZUF1dGhvcnNSZXNwb25zZRIxCgZhdXRob3IYASABKAsyIS5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkF1dGhvciItChtCcm93c2VDbGFzc2lmaWNhdGlvblJlcXVlc3QSDgoGcHJlZml4GAEgASgJIowBChxCcm93c2VDbGFzc2lmaWNhdGlvblJlc3BvbnNlEjwKBW5vZGVzGAEgAygLMi0uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5DbGFzc2lmaWNhdGlvbk5vZGUSLgoFYm9va3MYAiADKAsyHy5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkJvb2siQAoSQ2xhc3NpZmljYXRpb25Ob2RlEgwKBGNvZGUYASABKAkSDQoFbGFiZWwYAiABKAkSDQoFY291bnQYAyABKAUqXAoLSW1hZ2VPcmlnaW4SGAoUSU1BR0VfT1JJR0lOX1VOS05PV04QABIMCghQUk9WSURFUhABEggKBFVTRVIQAhINCglHRU5FUkFURUQQAxIMCghTRUxFQ1RFRBAEKm0KDkF0dGFjaG1lbnRLaW5kEhsKF0FUVEFDSE1FTlRfS0lORF9VTktOT1dOEAASDgoKQkFDS19DT1ZFUhABEgkKBVNQSU5FEAISDAoISU5URVJJT1IQAxIKCgZEQU1BR0UQBBIJCgVPVEhFUhAFKkkKDURhdGVQcmVjaXNpb24SGgoWREFURV9QUkVDSVNJT05fVU5LTk9XThAAEggKBFlFQVIQARIJCgVNT05USBACEgcKA0RBWRADKn4KD0NvbnRyaWJ1dG9yUm9sZRIcChhDT05UUklCVVRPUl9ST0xFX1VOS05PV04QABIKCgZBVVRIT1IQARIOCgpUUkFOU0xBVE9SEAISDwoLSUxMVVNUUkFUT1IQAxIKCgZFRElUT1IQBBIUChBPUklHSU5BTF9DUkVBVE9SEAUqaQoOSWRlbnRpZmllclR5cGUSGwoXSURFTlRJRklFUl9UWVBFX1VOS05PV04QABIICgRJU0JOEAESCAoESlBOTxACEggKBE5DSUQQAxIICgRBU0lOEAQSCAoESVNTThAFEggKBE9DTEMQBioyCghMYW5ndWFnZRILCgdVTktOT1dOEAASCwoHRU5HTElTSBABEgwKCEpBUEFORVNFEAIytRQKFUJvb2tNYW5hZ2VtZW50U2VydmljZRJgCgdQdXRCb29rEikuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5QdXRCb29rUmVxdWVzdBoqLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuUHV0Qm9va1Jlc3BvbnNlEmkKCkNyZWF0ZUJvb2sSLC5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkNyZWF0ZUJvb2tSZXF1ZXN0Gi0uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5DcmVhdGVCb29rUmVzcG9uc2USYAoHR2V0Qm9vaxIpLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuR2V0Qm9va1JlcXVlc3QaKi5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkdldEJvb2tSZXNwb25zZRJsCgtHZXRBbGxCb29rcxItLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuR2V0QWxsQm9va3NSZXF1ZXN0Gi4uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5HZXRBbGxCb29rc1Jlc3BvbnNlEmkKClNlYXJjaEJvb2sSLC5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLlNlYXJjaEJvb2tSZXF1ZXN0Gi0uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5TZWFyY2hCb29rUmVzcG9uc2USaQoKUmVuYW1lQm9vaxIsLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuUmVuYW1lQm9va1JlcXVlc3QaLS5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLlJlbmFtZUJvb2tSZXNwb25zZRJpCgpEZWxldGVCb29rEiwuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5EZWxldGVCb29rUmVxdWVzdBotLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuRGVsZXRlQm9va1Jlc3BvbnNlEnIKDVNlYXJjaENhdGFsb2cSLy5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLlNlYXJjaENhdGFsb2dSZXF1ZXN0GjAuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5TZWFyY2hDYXRhbG9nUmVzcG9uc2USbgoLVXBsb2FkQ292ZXISLS5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLlVwbG9hZENvdmVyUmVxdWVzdBouLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuVXBsb2FkQ292ZXJSZXNwb25zZSgBEoQBChNMaXN0Q292ZXJDYW5kaWRhdGVzEjUuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5MaXN0Q292ZXJDYW5kaWRhdGVzUmVxdWVzdBo2LmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuTGlzdENvdmVyQ2FuZGlkYXRlc1Jlc3BvbnNlEmwKC1NlbGVjdENvdmVyEi0uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5TZWxlY3RDb3ZlclJlcXVlc3QaLi5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLlNlbGVjdENvdmVyUmVzcG9uc2USfQoQVXBsb2FkQXR0YWNobWVudBIyLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuVXBsb2FkQXR0YWNobWVudFJlcXVlc3QaMy5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLlVwbG9hZEF0dGFjaG1lbnRSZXNwb25zZSgBEngKD0xpc3RBdHRhY2htZW50cxIxLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuTGlzdEF0dGFjaG1lbnRzUmVxdWVzdBoyLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuTGlzdEF0dGFjaG1lbnRzUmVzcG9uc2USewoQRGVsZXRlQXR0YWNobWVudBIyLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuRGVsZXRlQXR0YWNobWVudFJlcXVlc3QaMy5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkRlbGV0ZUF0dGFjaG1lbnRSZXNwb25zZRKBAQoSUmVvcmRlckF0dGFjaG1lbnRzEjQuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5SZW9yZGVyQXR0YWNobWVudHNSZXF1ZXN0GjUuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5SZW9yZGVyQXR0YWNobWVudHNSZXNwb25zZRJsCgtMaXN0QXV0aG9ycxItLmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuTGlzdEF1dGhvcnNSZXF1ZXN0Gi4uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5MaXN0QXV0aG9yc1Jlc3BvbnNlEn4KEUxpc3RCb29rc0J5QXV0aG9yEjMuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5MaXN0Qm9va3NCeUF1dGhvclJlcXVlc3QaNC5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkxpc3RCb29rc0J5QXV0aG9yUmVzcG9uc2USbwoMVXBkYXRlQXV0aG9yEi4uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5VcGRhdGVBdXRob3JSZXF1ZXN0Gi8uYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5VcGRhdGVBdXRob3JSZXNwb25zZRJvCgxNZXJnZUF1dGhvcnMSLi5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLk1lcmdlQXV0aG9yc1JlcXVlc3QaLy5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLk1lcmdlQXV0aG9yc1Jlc3BvbnNlEocBChRCcm93c2VDbGFzc2lmaWNhdGlvbhI2LmJvb2tfbWFuYWdlbWVudF9zeXN0ZW0udjEuQnJvd3NlQ2xhc3NpZmljYXRpb25SZXF1ZXN0GjcuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5Ccm93c2VDbGFzc2lmaWNhdGlvblJlc3BvbnNlEn4KEUxpc3RQcm92aWRlckNhY2hlEjMuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5MaXN0UHJvdmlkZXJDYWNoZVJlcXVlc3QaNC5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkxpc3RQcm92aWRlckNhY2hlUmVzcG9uc2USkAEKF0ludmFsaWRhdGVQcm92aWRlckNhY2hlEjkuYm9va19tYW5hZ2VtZW50X3N5c3RlbS52MS5JbnZhbGlkYXRlUHJvdmlkZXJDYWNoZVJlcXVlc3QaOi5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxLkludmFsaWRhdGVQcm92aWRlckNhY2hlUmVzcG9uc2VCkwIKHWNvbS5ib29rX21hbmFnZW1lbnRfc3lzdGVtLnYxQglCb29rUHJvdG9QAVpqZ2l0aHViLmNvbS9ueWFoYWhhbm9oYS9Cb29rTWFuYWdlbWVudFN5c3RlbS9iYWNrZW5kL2FwaS9ib29rX21hbmFnZW1lbnRfc3lzdGVtL3YxO2Jvb2tfbWFuYWdlbWVudF9zeXN0ZW12MaICA0JYWKoCF0Jvb2tNYW5hZ2VtZW50U3lzdGVtLlYxygIXQm9va01hbmFnZW1lbnRTeXN0ZW1cVjHiAiNCb29rTWFuYWdlbWVudFN5c3RlbVxWMVxHUEJNZXRhZGF0YeoCGEJvb2tNYW5hZ2VtZW50U3lzdGVtOjpWMWIGcHJvdG8z");
 
 /**
  * @generated from message book_management_system.v1.PutBookRequest
@@ -20,6 +20,13 @@ export type PutBookRequest = Message<"book_management_system.v1.PutBookRequest">
    * @generated from field: string isbn = 1;
    */
   isbn: string;
+
+  /**
+   * ISBN の無い本を JP番号などで登録する場合
+   *
+   * @generated from field: book_management_system.v1.Identifier identifier = 2;
+   */
+  identifier?: Identifier;
 };
 
 /**
@@ -46,6 +53,42 @@ export type PutBookResponse = Message<"book_management_system.v1.PutBookResponse
 export const PutBookResponseSchema: GenMessage<PutBookResponse> = /*@__PURE__*/
   messageDesc(file_book_management_system_v1_book, 1);
 
+/**
+ * 書誌情報を手入力で登録する
+ *
+ * @generated from message book_management_system.v1.CreateBookRequest
+ */
+export type CreateBookRequest = Message<"book_management_system.v1.CreateBookRequest"> & {
+  /**
+   * @generated from field: book_management_system.v1.Book book = 1;
+   */
+  book?: Book;
+};
+
+/**
+ * Describes the message book_management_system.v1.CreateBookRequest.
+ * Use `create(CreateBookRequestSchema)` to create a new message.
+ */
+export const CreateBookRequestSchema: GenMessage<CreateBookRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 2);
+
+/**
+ * @generated from message book_management_system.v1.CreateBookResponse
+ */
+export type CreateBookResponse = Message<"book_management_system.v1.CreateBookResponse"> & {
+  /**
+   * @generated from field: book_management_system.v1.Book book = 1;
+   */
+  book?: Book;
+};
+
+/**
+ * Describes the message book_management_system.v1.CreateBookResponse.
+ * Use `create(CreateBookResponseSchema)` to create a new message.
+ */
+export const CreateBookResponseSchema: GenMessage<CreateBookResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 3);
+
 /**
  * @generated from message book_management_system.v1.GetBookRequest
  */
@@ -54,6 +97,11 @@ export type GetBookRequest = Message<"book_management_system.v1.GetBookRequest">
    * @generated from field: string isbn = 1;
    */
   isbn: string;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
 };
 
 /**
@@ -61,7 +109,7 @@ export type GetBookRequest = Message<"book_management_system.v1.GetBookRequest">
  * Use `create(GetBookRequestSchema)` to create a new message.
  */
 export const GetBookRequestSchema: GenMessage<GetBookRequest> = /*@__PURE__*/
-  messageDesc(file_book_management_system_v1_book, 2);
+  messageDesc(file_book_management_system_v1_book, 4);
 
 /**
  * @generated from message book_management_system.v1.GetBookResponse
@@ -78,7 +126,7 @@ export type GetBookResponse = Message<"book_management_system.v1.GetBookResponse
  * Use `create(GetBookResponseSchema)` to create a new message.
  */
 export const GetBookResponseSchema: GenMessage<GetBookResponse> = /*@__PURE__*/
-  messageDesc(file_book_management_system_v1_book, 3);
+  messageDesc(file_book_management_system_v1_book, 5);
 
 /**
  * @generated from message book_management_system.v1.GetAllBooksRequest
@@ -91,7 +139,7 @@ export type GetAllBooksRequest = Message<"book_management_system.v1.GetAllBooksR
  * Use `create(GetAllBooksRequestSchema)` to create a new message.
  */
 export const GetAllBooksRequestSchema: GenMessage<GetAllBooksRequest> = /*@__PURE__*/
-  messageDesc(file_book_management_system_v1_book, 4);
+  messageDesc(file_book_management_system_v1_book, 6);
 
 /**
  * @generated from message book_management_system.v1.GetAllBooksResponse
@@ -108,16 +156,28 @@ export type GetAllBooksResponse = Message<"book_management_system.v1.GetAllBooks
  * Use `create(GetAllBooksResponseSchema)` to create a new message.
  */
 export const GetAllBooksResponseSchema: GenMessage<GetAllBooksResponse> = /*@__PURE__*/
-  messageDesc(file_book_management_system_v1_book, 5);
+  messageDesc(file_book_management_system_v1_book, 7);
 
 /**
  * @generated from message book_management_system.v1.SearchBookRequest
  */
 export type SearchBookRequest = Message<"book_management_system.v1.SearchBookRequest"> & {
   /**
+   * 空のフィールドはその条件で絞らない
+   *
    * @generated from field: string title = 1;
    */
   title: string;
+
+  /**
+   * @generated from field: string publisher = 2;
+   */
+  publisher: string;
+
+  /**
+   * @generated from field: string subject = 3;
+   */
+  subject: string;
 };
 
 /**
@@ -125,7 +185,7 @@ export type SearchBookRequest = Message<"book_management_system.v1.SearchBookReq
  * Use `create(SearchBookRequestSchema)` to create a new message.
  */
 export const SearchBookRequestSchema: GenMessage<SearchBookRequest> = /*@__PURE__*/
-  messageDesc(file_book_management_system_v1_book, 6);
+  messageDesc(file_book_management_system_v1_book, 8);
 
 /**
  * @generated from message book_management_system.v1.SearchBookResponse
@@ -142,7 +202,7 @@ export type SearchBookResponse = Message<"book_management_system.v1.SearchBookRe
  * Use `create(SearchBookResponseSchema)` to create a new message.
  */
 export const SearchBookResponseSchema: GenMessage<SearchBookResponse> = /*@__PURE__*/
-  messageDesc(file_book_management_system_v1_book, 7);
+  messageDesc(file_book_management_system_v1_book, 9);
 
 /**
  * @generated from message book_management_system.v1.Book
@@ -169,19 +229,117 @@ export type Book = Message<"book_management_system.v1.Book"> & {
   description: string;
 
   /**
+   * 分かっている精度で "2006", "2006-01", "2006-01-02" のいずれか
+   *
    * @generated from field: string publishdate = 5;
    */
   publishdate: string;
 
   /**
+   * languages に置き換えた (languages の先頭が日本語か英語の場合だけ入る)
+   *
    * @generated from field: book_management_system.v1.Language language = 6;
    */
   language: Language;
 
   /**
+   * 保存した表紙の完全な URL (public_url/images/... か署名付き URL)
+   *
    * @generated from field: string imageurl = 7;
    */
   imageurl: string;
+
+  /**
+   * @generated from field: string id = 8;
+   */
+  id: string;
+
+  /**
+   * @generated from field: repeated book_management_system.v1.Identifier identifiers = 9;
+   */
+  identifiers: Identifier[];
+
+  /**
+   * @generated from field: string publisher = 10;
+   */
+  publisher: string;
+
+  /**
+   * @generated from field: int32 pages = 11;
+   */
+  pages: number;
+
+  /**
+   * @generated from field: repeated string subjects = 12;
+   */
+  subjects: string[];
+
+  /**
+   * @generated from field: string edition = 13;
+   */
+  edition: string;
+
+  /**
+   * @generated from field: book_management_system.v1.Price price = 14;
+   */
+  price?: Price;
+
+  /**
+   * authors は表示用の著者名で、役割は contributors にある
+   *
+   * @generated from field: repeated book_management_system.v1.Contributor contributors = 15;
+   */
+  contributors: Contributor[];
+
+  /**
+   * 日本十進分類法 (NDC) の分類記号
+   *
+   * @generated from field: string ndc = 16;
+   */
+  ndc: string;
+
+  /**
+   * シリーズ名 ("新潮文庫" など)
+   *
+   * @generated from field: string series = 17;
+   */
+  series: string;
+
+  /**
+   * @generated from field: book_management_system.v1.DatePrecision publishdate_precision = 18;
+   */
+  publishdatePrecision: DatePrecision;
+
+  /**
+   * 本文の言語の ISO 639 コード ("ja", "en", "zh" など)。対訳本などは複数
+   *
+   * @generated from field: repeated string languages = 19;
+   */
+  languages: string[];
+
+  /**
+   * 表紙の代表色 ("#rrggbb") と読み込み中に表示する blurhash
+   *
+   * @generated from field: string image_color = 20;
+   */
+  imageColor: string;
+
+  /**
+   * @generated from field: string image_blurhash = 21;
+   */
+  imageBlurhash: string;
+
+  /**
+   * @generated from field: book_management_system.v1.ImageOrigin image_origin = 22;
+   */
+  imageOrigin: ImageOrigin;
+
+  /**
+   * 書名の読み (分からない場合は空)
+   *
+   * @generated from field: string title_reading = 23;
+   */
+  titleReading: string;
 };
 
 /**
@@ -189,7 +347,89 @@ export type Book = Message<"book_management_system.v1.Book"> & {
  * Use `create(BookSchema)` to create a new message.
  */
 export const BookSchema: GenMessage<Book> = /*@__PURE__*/
-  messageDesc(file_book_management_system_v1_book, 8);
+  messageDesc(file_book_management_system_v1_book, 10);
+
+/**
+ * @generated from message book_management_system.v1.Contributor
+ */
+export type Contributor = Message<"book_management_system.v1.Contributor"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: book_management_system.v1.ContributorRole role = 2;
+   */
+  role: ContributorRole;
+
+  /**
+   * 登録済みの本の場合は Author の id
+   *
+   * @generated from field: int64 author_id = 3;
+   */
+  authorId: bigint;
+
+  /**
+   * 名前の読み (分からない場合は空)
+   *
+   * @generated from field: string reading = 4;
+   */
+  reading: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.Contributor.
+ * Use `create(ContributorSchema)` to create a new message.
+ */
+export const ContributorSchema: GenMessage<Contributor> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 11);
+
+/**
+ * @generated from message book_management_system.v1.Price
+ */
+export type Price = Message<"book_management_system.v1.Price"> & {
+  /**
+   * @generated from field: double amount = 1;
+   */
+  amount: number;
+
+  /**
+   * ISO 4217 の通貨コード
+   *
+   * @generated from field: string currency = 2;
+   */
+  currency: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.Price.
+ * Use `create(PriceSchema)` to create a new message.
+ */
+export const PriceSchema: GenMessage<Price> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 12);
+
+/**
+ * @generated from message book_management_system.v1.Identifier
+ */
+export type Identifier = Message<"book_management_system.v1.Identifier"> & {
+  /**
+   * @generated from field: book_management_system.v1.IdentifierType type = 1;
+   */
+  type: IdentifierType;
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.Identifier.
+ * Use `create(IdentifierSchema)` to create a new message.
+ */
+export const IdentifierSchema: GenMessage<Identifier> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 13);
 
 /**
  * @generated from message book_management_system.v1.RenameBookRequest
@@ -204,6 +444,11 @@ export type RenameBookRequest = Message<"book_management_system.v1.RenameBookReq
    * @generated from field: string title = 2;
    */
   title: string;
+
+  /**
+   * @generated from field: string id = 3;
+   */
+  id: string;
 };
 
 /**
@@ -211,7 +456,7 @@ export type RenameBookRequest = Message<"book_management_system.v1.RenameBookReq
  * Use `create(RenameBookRequestSchema)` to create a new message.
  */
 export const RenameBookRequestSchema: GenMessage<RenameBookRequest> = /*@__PURE__*/
-  messageDesc(file_book_management_system_v1_book, 9);
+  messageDesc(file_book_management_system_v1_book, 14);
 
 /**
  * @generated from message book_management_system.v1.RenameBookResponse
@@ -224,7 +469,7 @@ export type RenameBookResponse = Message<"book_management_system.v1.RenameBookRe
  * Use `create(RenameBookResponseSchema)` to create a new message.
  */
 export const RenameBookResponseSchema: GenMessage<RenameBookResponse> = /*@__PURE__*/
-  messageDesc(file_book_management_system_v1_book, 10);
+  messageDesc(file_book_management_system_v1_book, 15);
 
 /**
  * @generated from message book_management_system.v1.DeleteBookRequest
@@ -234,6 +479,11 @@ export type DeleteBookRequest = Message<"book_management_system.v1.DeleteBookReq
    * @generated from field: string isbn = 1;
    */
   isbn: string;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
 };
 
 /**
@@ -241,7 +491,7 @@ export type DeleteBookRequest = Message<"book_management_system.v1.DeleteBookReq
  * Use `create(DeleteBookRequestSchema)` to create a new message.
  */
 export const DeleteBookRequestSchema: GenMessage<DeleteBookRequest> = /*@__PURE__*/
-  messageDesc(file_book_management_system_v1_book, 11);
+  messageDesc(file_book_management_system_v1_book, 16);
 
 /**
  * @generated from message book_management_system.v1.DeleteBookResponse
@@ -254,85 +504,1284 @@ export type DeleteBookResponse = Message<"book_management_system.v1.DeleteBookRe
  * Use `create(DeleteBookResponseSchema)` to create a new message.
  */
 export const DeleteBookResponseSchema: GenMessage<DeleteBookResponse> = /*@__PURE__*/
-  messageDesc(file_book_management_system_v1_book, 12);
+  messageDesc(file_book_management_system_v1_book, 17);
 
 /**
- * @generated from enum book_management_system.v1.Language
+ * 表紙の画像を分割して送る
+ * 最初のメッセージで id か isbn を指定し、chunk を順に送る
+ *
+ * @generated from message book_management_system.v1.UploadCoverRequest
  */
-export enum Language {
+export type UploadCoverRequest = Message<"book_management_system.v1.UploadCoverRequest"> & {
   /**
-   * @generated from enum value: UNKNOWN = 0;
+   * @generated from field: string id = 1;
    */
-  UNKNOWN = 0,
+  id: string;
 
   /**
-   * @generated from enum value: ENGLISH = 1;
+   * @generated from field: string isbn = 2;
    */
-  ENGLISH = 1,
+  isbn: string;
 
   /**
-   * @generated from enum value: JAPANESE = 2;
+   * @generated from field: bytes chunk = 3;
    */
-  JAPANESE = 2,
-}
+  chunk: Uint8Array;
+};
 
 /**
- * Describes the enum book_management_system.v1.Language.
+ * Describes the message book_management_system.v1.UploadCoverRequest.
+ * Use `create(UploadCoverRequestSchema)` to create a new message.
  */
-export const LanguageSchema: GenEnum<Language> = /*@__PURE__*/
-  enumDesc(file_book_management_system_v1_book, 0);
+export const UploadCoverRequestSchema: GenMessage<UploadCoverRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 18);
 
 /**
- * @generated from service book_management_system.v1.BookManagementService
+ * @generated from message book_management_system.v1.UploadCoverResponse
  */
-export const BookManagementService: GenService<{
+export type UploadCoverResponse = Message<"book_management_system.v1.UploadCoverResponse"> & {
   /**
-   * @generated from rpc book_management_system.v1.BookManagementService.PutBook
+   * @generated from field: book_management_system.v1.Book book = 1;
    */
-  putBook: {
-    methodKind: "unary";
-    input: typeof PutBookRequestSchema;
-    output: typeof PutBookResponseSchema;
-  },
+  book?: Book;
+};
+
+/**
+ * Describes the message book_management_system.v1.UploadCoverResponse.
+ * Use `create(UploadCoverResponseSchema)` to create a new message.
+ */
+export const UploadCoverResponseSchema: GenMessage<UploadCoverResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 19);
+
+/**
+ * 表紙の他に本に付けた写真
+ *
+ * @generated from message book_management_system.v1.Attachment
+ */
+export type Attachment = Message<"book_management_system.v1.Attachment"> & {
   /**
-   * @generated from rpc book_management_system.v1.BookManagementService.GetBook
+   * @generated from field: string id = 1;
    */
-  getBook: {
-    methodKind: "unary";
-    input: typeof GetBookRequestSchema;
-    output: typeof GetBookResponseSchema;
-  },
+  id: string;
+
   /**
-   * @generated from rpc book_management_system.v1.BookManagementService.GetAllBooks
+   * @generated from field: book_management_system.v1.AttachmentKind kind = 2;
    */
-  getAllBooks: {
-    methodKind: "unary";
-    input: typeof GetAllBooksRequestSchema;
-    output: typeof GetAllBooksResponseSchema;
-  },
+  kind: AttachmentKind;
+
   /**
-   * @generated from rpc book_management_system.v1.BookManagementService.SearchBook
+   * @generated from field: string caption = 3;
    */
-  searchBook: {
-    methodKind: "unary";
-    input: typeof SearchBookRequestSchema;
-    output: typeof SearchBookResponseSchema;
-  },
+  caption: string;
+
   /**
-   * @generated from rpc book_management_system.v1.BookManagementService.RenameBook
+   * 画像の完全な URL (public_url/images/... か署名付き URL)
+   *
+   * @generated from field: string imageurl = 4;
    */
-  renameBook: {
-    methodKind: "unary";
-    input: typeof RenameBookRequestSchema;
-    output: typeof RenameBookResponseSchema;
-  },
+  imageurl: string;
+
   /**
-   * @generated from rpc book_management_system.v1.BookManagementService.DeleteBook
+   * @generated from field: int32 width = 5;
    */
-  deleteBook: {
-    methodKind: "unary";
-    input: typeof DeleteBookRequestSchema;
-    output: typeof DeleteBookResponseSchema;
+  width: number;
+
+  /**
+   * @generated from field: int32 height = 6;
+   */
+  height: number;
+
+  /**
+   * 0 から始まる表示順
+   *
+   * @generated from field: int32 position = 7;
+   */
+  position: number;
+};
+
+/**
+ * Describes the message book_management_system.v1.Attachment.
+ * Use `create(AttachmentSchema)` to create a new message.
+ */
+export const AttachmentSchema: GenMessage<Attachment> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 20);
+
+/**
+ * 最初のメッセージで本 (id か isbn) と kind, caption を指定し、画像を chunk で送る
+ *
+ * @generated from message book_management_system.v1.UploadAttachmentRequest
+ */
+export type UploadAttachmentRequest = Message<"book_management_system.v1.UploadAttachmentRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string isbn = 2;
+   */
+  isbn: string;
+
+  /**
+   * @generated from field: book_management_system.v1.AttachmentKind kind = 3;
+   */
+  kind: AttachmentKind;
+
+  /**
+   * @generated from field: string caption = 4;
+   */
+  caption: string;
+
+  /**
+   * @generated from field: bytes chunk = 5;
+   */
+  chunk: Uint8Array;
+};
+
+/**
+ * Describes the message book_management_system.v1.UploadAttachmentRequest.
+ * Use `create(UploadAttachmentRequestSchema)` to create a new message.
+ */
+export const UploadAttachmentRequestSchema: GenMessage<UploadAttachmentRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 21);
+
+/**
+ * @generated from message book_management_system.v1.UploadAttachmentResponse
+ */
+export type UploadAttachmentResponse = Message<"book_management_system.v1.UploadAttachmentResponse"> & {
+  /**
+   * @generated from field: book_management_system.v1.Attachment attachment = 1;
+   */
+  attachment?: Attachment;
+};
+
+/**
+ * Describes the message book_management_system.v1.UploadAttachmentResponse.
+ * Use `create(UploadAttachmentResponseSchema)` to create a new message.
+ */
+export const UploadAttachmentResponseSchema: GenMessage<UploadAttachmentResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 22);
+
+/**
+ * @generated from message book_management_system.v1.ListAttachmentsRequest
+ */
+export type ListAttachmentsRequest = Message<"book_management_system.v1.ListAttachmentsRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string isbn = 2;
+   */
+  isbn: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.ListAttachmentsRequest.
+ * Use `create(ListAttachmentsRequestSchema)` to create a new message.
+ */
+export const ListAttachmentsRequestSchema: GenMessage<ListAttachmentsRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 23);
+
+/**
+ * @generated from message book_management_system.v1.ListAttachmentsResponse
+ */
+export type ListAttachmentsResponse = Message<"book_management_system.v1.ListAttachmentsResponse"> & {
+  /**
+   * @generated from field: repeated book_management_system.v1.Attachment attachments = 1;
+   */
+  attachments: Attachment[];
+};
+
+/**
+ * Describes the message book_management_system.v1.ListAttachmentsResponse.
+ * Use `create(ListAttachmentsResponseSchema)` to create a new message.
+ */
+export const ListAttachmentsResponseSchema: GenMessage<ListAttachmentsResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 24);
+
+/**
+ * @generated from message book_management_system.v1.DeleteAttachmentRequest
+ */
+export type DeleteAttachmentRequest = Message<"book_management_system.v1.DeleteAttachmentRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string isbn = 2;
+   */
+  isbn: string;
+
+  /**
+   * @generated from field: string attachment_id = 3;
+   */
+  attachmentId: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.DeleteAttachmentRequest.
+ * Use `create(DeleteAttachmentRequestSchema)` to create a new message.
+ */
+export const DeleteAttachmentRequestSchema: GenMessage<DeleteAttachmentRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 25);
+
+/**
+ * @generated from message book_management_system.v1.DeleteAttachmentResponse
+ */
+export type DeleteAttachmentResponse = Message<"book_management_system.v1.DeleteAttachmentResponse"> & {
+};
+
+/**
+ * Describes the message book_management_system.v1.DeleteAttachmentResponse.
+ * Use `create(DeleteAttachmentResponseSchema)` to create a new message.
+ */
+export const DeleteAttachmentResponseSchema: GenMessage<DeleteAttachmentResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 26);
+
+/**
+ * @generated from message book_management_system.v1.ReorderAttachmentsRequest
+ */
+export type ReorderAttachmentsRequest = Message<"book_management_system.v1.ReorderAttachmentsRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string isbn = 2;
+   */
+  isbn: string;
+
+  /**
+   * 本の写真の id を全て新しい順に並べたもの
+   *
+   * @generated from field: repeated string attachment_ids = 3;
+   */
+  attachmentIds: string[];
+};
+
+/**
+ * Describes the message book_management_system.v1.ReorderAttachmentsRequest.
+ * Use `create(ReorderAttachmentsRequestSchema)` to create a new message.
+ */
+export const ReorderAttachmentsRequestSchema: GenMessage<ReorderAttachmentsRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 27);
+
+/**
+ * @generated from message book_management_system.v1.ReorderAttachmentsResponse
+ */
+export type ReorderAttachmentsResponse = Message<"book_management_system.v1.ReorderAttachmentsResponse"> & {
+  /**
+   * @generated from field: repeated book_management_system.v1.Attachment attachments = 1;
+   */
+  attachments: Attachment[];
+};
+
+/**
+ * Describes the message book_management_system.v1.ReorderAttachmentsResponse.
+ * Use `create(ReorderAttachmentsResponseSchema)` to create a new message.
+ */
+export const ReorderAttachmentsResponseSchema: GenMessage<ReorderAttachmentsResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 28);
+
+/**
+ * @generated from message book_management_system.v1.ListCoverCandidatesRequest
+ */
+export type ListCoverCandidatesRequest = Message<"book_management_system.v1.ListCoverCandidatesRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string isbn = 2;
+   */
+  isbn: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.ListCoverCandidatesRequest.
+ * Use `create(ListCoverCandidatesRequestSchema)` to create a new message.
+ */
+export const ListCoverCandidatesRequestSchema: GenMessage<ListCoverCandidatesRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 29);
+
+/**
+ * @generated from message book_management_system.v1.ListCoverCandidatesResponse
+ */
+export type ListCoverCandidatesResponse = Message<"book_management_system.v1.ListCoverCandidatesResponse"> & {
+  /**
+   * @generated from field: repeated book_management_system.v1.CoverCandidate candidates = 1;
+   */
+  candidates: CoverCandidate[];
+};
+
+/**
+ * Describes the message book_management_system.v1.ListCoverCandidatesResponse.
+ * Use `create(ListCoverCandidatesResponseSchema)` to create a new message.
+ */
+export const ListCoverCandidatesResponseSchema: GenMessage<ListCoverCandidatesResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 30);
+
+/**
+ * プロバイダーが返した表紙の候補
+ *
+ * @generated from message book_management_system.v1.CoverCandidate
+ */
+export type CoverCandidate = Message<"book_management_system.v1.CoverCandidate"> & {
+  /**
+   * プロバイダーの名前
+   *
+   * @generated from field: string source = 1;
+   */
+  source: string;
+
+  /**
+   * プロバイダーでの大きさの区別 ("thumbnail", "large" など)
+   *
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string url = 3;
+   */
+  url: string;
+
+  /**
+   * 取得できなかった場合は 0
+   *
+   * @generated from field: int32 width = 4;
+   */
+  width: number;
+
+  /**
+   * @generated from field: int32 height = 5;
+   */
+  height: number;
+
+  /**
+   * 今の表紙の取得元か
+   *
+   * @generated from field: bool selected = 6;
+   */
+  selected: boolean;
+};
+
+/**
+ * Describes the message book_management_system.v1.CoverCandidate.
+ * Use `create(CoverCandidateSchema)` to create a new message.
+ */
+export const CoverCandidateSchema: GenMessage<CoverCandidate> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 31);
+
+/**
+ * @generated from message book_management_system.v1.SelectCoverRequest
+ */
+export type SelectCoverRequest = Message<"book_management_system.v1.SelectCoverRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string isbn = 2;
+   */
+  isbn: string;
+
+  /**
+   * ListCoverCandidates が返した url
+   *
+   * @generated from field: string url = 3;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.SelectCoverRequest.
+ * Use `create(SelectCoverRequestSchema)` to create a new message.
+ */
+export const SelectCoverRequestSchema: GenMessage<SelectCoverRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 32);
+
+/**
+ * @generated from message book_management_system.v1.SelectCoverResponse
+ */
+export type SelectCoverResponse = Message<"book_management_system.v1.SelectCoverResponse"> & {
+  /**
+   * @generated from field: book_management_system.v1.Book book = 1;
+   */
+  book?: Book;
+};
+
+/**
+ * Describes the message book_management_system.v1.SelectCoverResponse.
+ * Use `create(SelectCoverResponseSchema)` to create a new message.
+ */
+export const SelectCoverResponseSchema: GenMessage<SelectCoverResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 33);
+
+/**
+ * @generated from message book_management_system.v1.SearchCatalogRequest
+ */
+export type SearchCatalogRequest = Message<"book_management_system.v1.SearchCatalogRequest"> & {
+  /**
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string author = 2;
+   */
+  author: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.SearchCatalogRequest.
+ * Use `create(SearchCatalogRequestSchema)` to create a new message.
+ */
+export const SearchCatalogRequestSchema: GenMessage<SearchCatalogRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 34);
+
+/**
+ * @generated from message book_management_system.v1.SearchCatalogResponse
+ */
+export type SearchCatalogResponse = Message<"book_management_system.v1.SearchCatalogResponse"> & {
+  /**
+   * @generated from field: repeated book_management_system.v1.CatalogCandidate candidates = 1;
+   */
+  candidates: CatalogCandidate[];
+};
+
+/**
+ * Describes the message book_management_system.v1.SearchCatalogResponse.
+ * Use `create(SearchCatalogResponseSchema)` to create a new message.
+ */
+export const SearchCatalogResponseSchema: GenMessage<SearchCatalogResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 35);
+
+/**
+ * @generated from message book_management_system.v1.CatalogCandidate
+ */
+export type CatalogCandidate = Message<"book_management_system.v1.CatalogCandidate"> & {
+  /**
+   * @generated from field: book_management_system.v1.Book book = 1;
+   */
+  book?: Book;
+
+  /**
+   * 候補を返したプロバイダー
+   *
+   * @generated from field: repeated string sources = 2;
+   */
+  sources: string[];
+};
+
+/**
+ * Describes the message book_management_system.v1.CatalogCandidate.
+ * Use `create(CatalogCandidateSchema)` to create a new message.
+ */
+export const CatalogCandidateSchema: GenMessage<CatalogCandidate> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 36);
+
+/**
+ * @generated from message book_management_system.v1.ProviderCacheEntry
+ */
+export type ProviderCacheEntry = Message<"book_management_system.v1.ProviderCacheEntry"> & {
+  /**
+   * @generated from field: string source = 1;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string isbn = 2;
+   */
+  isbn: string;
+
+  /**
+   * @generated from field: bool not_found = 3;
+   */
+  notFound: boolean;
+
+  /**
+   * @generated from field: string created_time = 4;
+   */
+  createdTime: string;
+
+  /**
+   * @generated from field: string expires_time = 5;
+   */
+  expiresTime: string;
+
+  /**
+   * @generated from field: string response = 6;
+   */
+  response: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.ProviderCacheEntry.
+ * Use `create(ProviderCacheEntrySchema)` to create a new message.
+ */
+export const ProviderCacheEntrySchema: GenMessage<ProviderCacheEntry> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 37);
+
+/**
+ * @generated from message book_management_system.v1.ListProviderCacheRequest
+ */
+export type ListProviderCacheRequest = Message<"book_management_system.v1.ListProviderCacheRequest"> & {
+  /**
+   * 空の場合は全て
+   *
+   * @generated from field: string isbn = 1;
+   */
+  isbn: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.ListProviderCacheRequest.
+ * Use `create(ListProviderCacheRequestSchema)` to create a new message.
+ */
+export const ListProviderCacheRequestSchema: GenMessage<ListProviderCacheRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 38);
+
+/**
+ * @generated from message book_management_system.v1.ListProviderCacheResponse
+ */
+export type ListProviderCacheResponse = Message<"book_management_system.v1.ListProviderCacheResponse"> & {
+  /**
+   * @generated from field: repeated book_management_system.v1.ProviderCacheEntry entries = 1;
+   */
+  entries: ProviderCacheEntry[];
+};
+
+/**
+ * Describes the message book_management_system.v1.ListProviderCacheResponse.
+ * Use `create(ListProviderCacheResponseSchema)` to create a new message.
+ */
+export const ListProviderCacheResponseSchema: GenMessage<ListProviderCacheResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 39);
+
+/**
+ * @generated from message book_management_system.v1.InvalidateProviderCacheRequest
+ */
+export type InvalidateProviderCacheRequest = Message<"book_management_system.v1.InvalidateProviderCacheRequest"> & {
+  /**
+   * 空の場合はその条件で絞らない
+   *
+   * @generated from field: string isbn = 1;
+   */
+  isbn: string;
+
+  /**
+   * @generated from field: string source = 2;
+   */
+  source: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.InvalidateProviderCacheRequest.
+ * Use `create(InvalidateProviderCacheRequestSchema)` to create a new message.
+ */
+export const InvalidateProviderCacheRequestSchema: GenMessage<InvalidateProviderCacheRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 40);
+
+/**
+ * @generated from message book_management_system.v1.InvalidateProviderCacheResponse
+ */
+export type InvalidateProviderCacheResponse = Message<"book_management_system.v1.InvalidateProviderCacheResponse"> & {
+};
+
+/**
+ * Describes the message book_management_system.v1.InvalidateProviderCacheResponse.
+ * Use `create(InvalidateProviderCacheResponseSchema)` to create a new message.
+ */
+export const InvalidateProviderCacheResponseSchema: GenMessage<InvalidateProviderCacheResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 41);
+
+/**
+ * Author は表記ゆれをまとめた人物
+ *
+ * @generated from message book_management_system.v1.Author
+ */
+export type Author = Message<"book_management_system.v1.Author"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * 代表の表記
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string reading = 3;
+   */
+  reading: string;
+
+  /**
+   * @generated from field: repeated string aliases = 4;
+   */
+  aliases: string[];
+};
+
+/**
+ * Describes the message book_management_system.v1.Author.
+ * Use `create(AuthorSchema)` to create a new message.
+ */
+export const AuthorSchema: GenMessage<Author> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 42);
+
+/**
+ * @generated from message book_management_system.v1.ListAuthorsRequest
+ */
+export type ListAuthorsRequest = Message<"book_management_system.v1.ListAuthorsRequest"> & {
+  /**
+   * 表記か別名に含まれる文字列 (空の場合は全て)
+   *
+   * @generated from field: string query = 1;
+   */
+  query: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.ListAuthorsRequest.
+ * Use `create(ListAuthorsRequestSchema)` to create a new message.
+ */
+export const ListAuthorsRequestSchema: GenMessage<ListAuthorsRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 43);
+
+/**
+ * @generated from message book_management_system.v1.ListAuthorsResponse
+ */
+export type ListAuthorsResponse = Message<"book_management_system.v1.ListAuthorsResponse"> & {
+  /**
+   * @generated from field: repeated book_management_system.v1.Author authors = 1;
+   */
+  authors: Author[];
+};
+
+/**
+ * Describes the message book_management_system.v1.ListAuthorsResponse.
+ * Use `create(ListAuthorsResponseSchema)` to create a new message.
+ */
+export const ListAuthorsResponseSchema: GenMessage<ListAuthorsResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 44);
+
+/**
+ * @generated from message book_management_system.v1.ListBooksByAuthorRequest
+ */
+export type ListBooksByAuthorRequest = Message<"book_management_system.v1.ListBooksByAuthorRequest"> & {
+  /**
+   * author_id が無い場合は name を別名も含めて探す
+   *
+   * @generated from field: int64 author_id = 1;
+   */
+  authorId: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.ListBooksByAuthorRequest.
+ * Use `create(ListBooksByAuthorRequestSchema)` to create a new message.
+ */
+export const ListBooksByAuthorRequestSchema: GenMessage<ListBooksByAuthorRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 45);
+
+/**
+ * @generated from message book_management_system.v1.ListBooksByAuthorResponse
+ */
+export type ListBooksByAuthorResponse = Message<"book_management_system.v1.ListBooksByAuthorResponse"> & {
+  /**
+   * @generated from field: book_management_system.v1.Author author = 1;
+   */
+  author?: Author;
+
+  /**
+   * @generated from field: repeated book_management_system.v1.Book books = 2;
+   */
+  books: Book[];
+};
+
+/**
+ * Describes the message book_management_system.v1.ListBooksByAuthorResponse.
+ * Use `create(ListBooksByAuthorResponseSchema)` to create a new message.
+ */
+export const ListBooksByAuthorResponseSchema: GenMessage<ListBooksByAuthorResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 46);
+
+/**
+ * @generated from message book_management_system.v1.UpdateAuthorRequest
+ */
+export type UpdateAuthorRequest = Message<"book_management_system.v1.UpdateAuthorRequest"> & {
+  /**
+   * @generated from field: book_management_system.v1.Author author = 1;
+   */
+  author?: Author;
+};
+
+/**
+ * Describes the message book_management_system.v1.UpdateAuthorRequest.
+ * Use `create(UpdateAuthorRequestSchema)` to create a new message.
+ */
+export const UpdateAuthorRequestSchema: GenMessage<UpdateAuthorRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 47);
+
+/**
+ * @generated from message book_management_system.v1.UpdateAuthorResponse
+ */
+export type UpdateAuthorResponse = Message<"book_management_system.v1.UpdateAuthorResponse"> & {
+  /**
+   * @generated from field: book_management_system.v1.Author author = 1;
+   */
+  author?: Author;
+};
+
+/**
+ * Describes the message book_management_system.v1.UpdateAuthorResponse.
+ * Use `create(UpdateAuthorResponseSchema)` to create a new message.
+ */
+export const UpdateAuthorResponseSchema: GenMessage<UpdateAuthorResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 48);
+
+/**
+ * @generated from message book_management_system.v1.MergeAuthorsRequest
+ */
+export type MergeAuthorsRequest = Message<"book_management_system.v1.MergeAuthorsRequest"> & {
+  /**
+   * source_ids の別名と本を target_id にまとめる
+   *
+   * @generated from field: int64 target_id = 1;
+   */
+  targetId: bigint;
+
+  /**
+   * @generated from field: repeated int64 source_ids = 2;
+   */
+  sourceIds: bigint[];
+};
+
+/**
+ * Describes the message book_management_system.v1.MergeAuthorsRequest.
+ * Use `create(MergeAuthorsRequestSchema)` to create a new message.
+ */
+export const MergeAuthorsRequestSchema: GenMessage<MergeAuthorsRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 49);
+
+/**
+ * @generated from message book_management_system.v1.MergeAuthorsResponse
+ */
+export type MergeAuthorsResponse = Message<"book_management_system.v1.MergeAuthorsResponse"> & {
+  /**
+   * @generated from field: book_management_system.v1.Author author = 1;
+   */
+  author?: Author;
+};
+
+/**
+ * Describes the message book_management_system.v1.MergeAuthorsResponse.
+ * Use `create(MergeAuthorsResponseSchema)` to create a new message.
+ */
+export const MergeAuthorsResponseSchema: GenMessage<MergeAuthorsResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 50);
+
+/**
+ * @generated from message book_management_system.v1.BrowseClassificationRequest
+ */
+export type BrowseClassificationRequest = Message<"book_management_system.v1.BrowseClassificationRequest"> & {
+  /**
+   * 空の場合は類、1 桁の場合は綱、2 桁の場合は目の一覧を返す
+   * 3 桁の場合はその目の本を返す
+   *
+   * @generated from field: string prefix = 1;
+   */
+  prefix: string;
+};
+
+/**
+ * Describes the message book_management_system.v1.BrowseClassificationRequest.
+ * Use `create(BrowseClassificationRequestSchema)` to create a new message.
+ */
+export const BrowseClassificationRequestSchema: GenMessage<BrowseClassificationRequest> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 51);
+
+/**
+ * @generated from message book_management_system.v1.BrowseClassificationResponse
+ */
+export type BrowseClassificationResponse = Message<"book_management_system.v1.BrowseClassificationResponse"> & {
+  /**
+   * @generated from field: repeated book_management_system.v1.ClassificationNode nodes = 1;
+   */
+  nodes: ClassificationNode[];
+
+  /**
+   * @generated from field: repeated book_management_system.v1.Book books = 2;
+   */
+  books: Book[];
+};
+
+/**
+ * Describes the message book_management_system.v1.BrowseClassificationResponse.
+ * Use `create(BrowseClassificationResponseSchema)` to create a new message.
+ */
+export const BrowseClassificationResponseSchema: GenMessage<BrowseClassificationResponse> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 52);
+
+/**
+ * @generated from message book_management_system.v1.ClassificationNode
+ */
+export type ClassificationNode = Message<"book_management_system.v1.ClassificationNode"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * 類と綱の名前 (目は空)
+   *
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: int32 count = 3;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message book_management_system.v1.ClassificationNode.
+ * Use `create(ClassificationNodeSchema)` to create a new message.
+ */
+export const ClassificationNodeSchema: GenMessage<ClassificationNode> = /*@__PURE__*/
+  messageDesc(file_book_management_system_v1_book, 53);
+
+/**
+ * @generated from enum book_management_system.v1.ImageOrigin
+ */
+export enum ImageOrigin {
+  /**
+   * @generated from enum value: IMAGE_ORIGIN_UNKNOWN = 0;
+   */
+  IMAGE_ORIGIN_UNKNOWN = 0,
+
+  /**
+   * プロバイダーから取得した表紙
+   *
+   * @generated from enum value: PROVIDER = 1;
+   */
+  PROVIDER = 1,
+
+  /**
+   * UploadCover でアップロードした表紙 (プロバイダーの表紙で置き換えない)
+   *
+   * @generated from enum value: USER = 2;
+   */
+  USER = 2,
+
+  /**
+   * 表紙が無い本に作った仮の表紙 (書名と著者を書いた SVG)
+   *
+   * @generated from enum value: GENERATED = 3;
+   */
+  GENERATED = 3,
+
+  /**
+   * SelectCover で候補から選んだ表紙 (プロバイダーの表紙で置き換えない)
+   *
+   * @generated from enum value: SELECTED = 4;
+   */
+  SELECTED = 4,
+}
+
+/**
+ * Describes the enum book_management_system.v1.ImageOrigin.
+ */
+export const ImageOriginSchema: GenEnum<ImageOrigin> = /*@__PURE__*/
+  enumDesc(file_book_management_system_v1_book, 0);
+
+/**
+ * @generated from enum book_management_system.v1.AttachmentKind
+ */
+export enum AttachmentKind {
+  /**
+   * @generated from enum value: ATTACHMENT_KIND_UNKNOWN = 0;
+   */
+  ATTACHMENT_KIND_UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: BACK_COVER = 1;
+   */
+  BACK_COVER = 1,
+
+  /**
+   * @generated from enum value: SPINE = 2;
+   */
+  SPINE = 2,
+
+  /**
+   * 本文やサインのあるページなど
+   *
+   * @generated from enum value: INTERIOR = 3;
+   */
+  INTERIOR = 3,
+
+  /**
+   * 傷や汚れ
+   *
+   * @generated from enum value: DAMAGE = 4;
+   */
+  DAMAGE = 4,
+
+  /**
+   * @generated from enum value: OTHER = 5;
+   */
+  OTHER = 5,
+}
+
+/**
+ * Describes the enum book_management_system.v1.AttachmentKind.
+ */
+export const AttachmentKindSchema: GenEnum<AttachmentKind> = /*@__PURE__*/
+  enumDesc(file_book_management_system_v1_book, 1);
+
+/**
+ * @generated from enum book_management_system.v1.DatePrecision
+ */
+export enum DatePrecision {
+  /**
+   * @generated from enum value: DATE_PRECISION_UNKNOWN = 0;
+   */
+  DATE_PRECISION_UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: YEAR = 1;
+   */
+  YEAR = 1,
+
+  /**
+   * @generated from enum value: MONTH = 2;
+   */
+  MONTH = 2,
+
+  /**
+   * @generated from enum value: DAY = 3;
+   */
+  DAY = 3,
+}
+
+/**
+ * Describes the enum book_management_system.v1.DatePrecision.
+ */
+export const DatePrecisionSchema: GenEnum<DatePrecision> = /*@__PURE__*/
+  enumDesc(file_book_management_system_v1_book, 2);
+
+/**
+ * @generated from enum book_management_system.v1.ContributorRole
+ */
+export enum ContributorRole {
+  /**
+   * @generated from enum value: CONTRIBUTOR_ROLE_UNKNOWN = 0;
+   */
+  CONTRIBUTOR_ROLE_UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: AUTHOR = 1;
+   */
+  AUTHOR = 1,
+
+  /**
+   * @generated from enum value: TRANSLATOR = 2;
+   */
+  TRANSLATOR = 2,
+
+  /**
+   * @generated from enum value: ILLUSTRATOR = 3;
+   */
+  ILLUSTRATOR = 3,
+
+  /**
+   * @generated from enum value: EDITOR = 4;
+   */
+  EDITOR = 4,
+
+  /**
+   * 原作者
+   *
+   * @generated from enum value: ORIGINAL_CREATOR = 5;
+   */
+  ORIGINAL_CREATOR = 5,
+}
+
+/**
+ * Describes the enum book_management_system.v1.ContributorRole.
+ */
+export const ContributorRoleSchema: GenEnum<ContributorRole> = /*@__PURE__*/
+  enumDesc(file_book_management_system_v1_book, 3);
+
+/**
+ * @generated from enum book_management_system.v1.IdentifierType
+ */
+export enum IdentifierType {
+  /**
+   * @generated from enum value: IDENTIFIER_TYPE_UNKNOWN = 0;
+   */
+  IDENTIFIER_TYPE_UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: ISBN = 1;
+   */
+  ISBN = 1,
+
+  /**
+   * 全国書誌番号
+   *
+   * @generated from enum value: JPNO = 2;
+   */
+  JPNO = 2,
+
+  /**
+   * @generated from enum value: NCID = 3;
+   */
+  NCID = 3,
+
+  /**
+   * @generated from enum value: ASIN = 4;
+   */
+  ASIN = 4,
+
+  /**
+   * @generated from enum value: ISSN = 5;
+   */
+  ISSN = 5,
+
+  /**
+   * @generated from enum value: OCLC = 6;
+   */
+  OCLC = 6,
+}
+
+/**
+ * Describes the enum book_management_system.v1.IdentifierType.
+ */
+export const IdentifierTypeSchema: GenEnum<IdentifierType> = /*@__PURE__*/
+  enumDesc(file_book_management_system_v1_book, 4);
+
+/**
+ * @generated from enum book_management_system.v1.Language
+ */
+export enum Language {
+  /**
+   * @generated from enum value: UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: ENGLISH = 1;
+   */
+  ENGLISH = 1,
+
+  /**
+   * @generated from enum value: JAPANESE = 2;
+   */
+  JAPANESE = 2,
+}
+
+/**
+ * Describes the enum book_management_system.v1.Language.
+ */
+export const LanguageSchema: GenEnum<Language> = /*@__PURE__*/
+  enumDesc(file_book_management_system_v1_book, 5);
+
+/**
+ * @generated from service book_management_system.v1.BookManagementService
+ */
+export const BookManagementService: GenService<{
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.PutBook
+   */
+  putBook: {
+    methodKind: "unary";
+    input: typeof PutBookRequestSchema;
+    output: typeof PutBookResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.CreateBook
+   */
+  createBook: {
+    methodKind: "unary";
+    input: typeof CreateBookRequestSchema;
+    output: typeof CreateBookResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.GetBook
+   */
+  getBook: {
+    methodKind: "unary";
+    input: typeof GetBookRequestSchema;
+    output: typeof GetBookResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.GetAllBooks
+   */
+  getAllBooks: {
+    methodKind: "unary";
+    input: typeof GetAllBooksRequestSchema;
+    output: typeof GetAllBooksResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.SearchBook
+   */
+  searchBook: {
+    methodKind: "unary";
+    input: typeof SearchBookRequestSchema;
+    output: typeof SearchBookResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.RenameBook
+   */
+  renameBook: {
+    methodKind: "unary";
+    input: typeof RenameBookRequestSchema;
+    output: typeof RenameBookResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.DeleteBook
+   */
+  deleteBook: {
+    methodKind: "unary";
+    input: typeof DeleteBookRequestSchema;
+    output: typeof DeleteBookResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.SearchCatalog
+   */
+  searchCatalog: {
+    methodKind: "unary";
+    input: typeof SearchCatalogRequestSchema;
+    output: typeof SearchCatalogResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.UploadCover
+   */
+  uploadCover: {
+    methodKind: "client_streaming";
+    input: typeof UploadCoverRequestSchema;
+    output: typeof UploadCoverResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.ListCoverCandidates
+   */
+  listCoverCandidates: {
+    methodKind: "unary";
+    input: typeof ListCoverCandidatesRequestSchema;
+    output: typeof ListCoverCandidatesResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.SelectCover
+   */
+  selectCover: {
+    methodKind: "unary";
+    input: typeof SelectCoverRequestSchema;
+    output: typeof SelectCoverResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.UploadAttachment
+   */
+  uploadAttachment: {
+    methodKind: "client_streaming";
+    input: typeof UploadAttachmentRequestSchema;
+    output: typeof UploadAttachmentResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.ListAttachments
+   */
+  listAttachments: {
+    methodKind: "unary";
+    input: typeof ListAttachmentsRequestSchema;
+    output: typeof ListAttachmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.DeleteAttachment
+   */
+  deleteAttachment: {
+    methodKind: "unary";
+    input: typeof DeleteAttachmentRequestSchema;
+    output: typeof DeleteAttachmentResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.ReorderAttachments
+   */
+  reorderAttachments: {
+    methodKind: "unary";
+    input: typeof ReorderAttachmentsRequestSchema;
+    output: typeof ReorderAttachmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.ListAuthors
+   */
+  listAuthors: {
+    methodKind: "unary";
+    input: typeof ListAuthorsRequestSchema;
+    output: typeof ListAuthorsResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.ListBooksByAuthor
+   */
+  listBooksByAuthor: {
+    methodKind: "unary";
+    input: typeof ListBooksByAuthorRequestSchema;
+    output: typeof ListBooksByAuthorResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.UpdateAuthor
+   */
+  updateAuthor: {
+    methodKind: "unary";
+    input: typeof UpdateAuthorRequestSchema;
+    output: typeof UpdateAuthorResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.MergeAuthors
+   */
+  mergeAuthors: {
+    methodKind: "unary";
+    input: typeof MergeAuthorsRequestSchema;
+    output: typeof MergeAuthorsResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.BrowseClassification
+   */
+  browseClassification: {
+    methodKind: "unary";
+    input: typeof BrowseClassificationRequestSchema;
+    output: typeof BrowseClassificationResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.ListProviderCache
+   */
+  listProviderCache: {
+    methodKind: "unary";
+    input: typeof ListProviderCacheRequestSchema;
+    output: typeof ListProviderCacheResponseSchema;
+  },
+  /**
+   * @generated from rpc book_management_system.v1.BookManagementService.InvalidateProviderCache
+   */
+  invalidateProviderCache: {
+    methodKind: "unary";
+    input: typeof InvalidateProviderCacheRequestSchema;
+    output: typeof InvalidateProviderCacheResponseSchema;
   },
 }> = /*@__PURE__*/
   serviceDesc(file_book_management_system_v1_book, 0);
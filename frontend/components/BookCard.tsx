@@ -55,9 +55,10 @@ export default function BookCard({ book, apiBaseUrl, onDelete, onRequestDelete,
     return parts[0];
   };
 
-  // バックエンドの画像URLを直接参照するように変更
+  // imageurl はバックエンドが完全な URL で返す (古いバックエンドはファイル名だけを返す)
   const imageUrl = book.imageurl
-    ? `${apiBaseUrl}/images/${book.imageurl}` : null;
+    ? (/^https?:\/\//.test(book.imageurl) ? book.imageurl : `${apiBaseUrl}/images/${book.imageurl}`)
+    : null;
 
   return (
     <div class="bookcard-horizontal">